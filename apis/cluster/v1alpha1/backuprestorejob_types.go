@@ -0,0 +1,171 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Delivery types of a BackupRestoreJob.
+const (
+	DeliveryTypeAutomated   = "automated"
+	DeliveryTypePointInTime = "pointInTime"
+	DeliveryTypeDownload    = "download"
+)
+
+// Condition types of a BackupRestoreJob.
+const (
+	// TypeComplete is true once the restore finished successfully.
+	TypeComplete xpv1.ConditionType = "Complete"
+	// TypeFailed is true once the restore failed, was cancelled or expired.
+	TypeFailed xpv1.ConditionType = "Failed"
+)
+
+// Condition reasons of a BackupRestoreJob.
+const (
+	ReasonRestoreFinished  xpv1.ConditionReason = "RestoreFinished"
+	ReasonRestoreFailed    xpv1.ConditionReason = "RestoreFailed"
+	ReasonRestoreCancelled xpv1.ConditionReason = "RestoreCancelled"
+	ReasonRestoreExpired   xpv1.ConditionReason = "RestoreExpired"
+)
+
+// RestoreComplete returns a condition that indicates the restore finished
+// successfully at the supplied time.
+func RestoreComplete(at metav1.Time) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeComplete,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: at,
+		Reason:             ReasonRestoreFinished,
+	}
+}
+
+// RestoreFailed returns a condition that indicates the restore stopped at
+// the supplied time without restoring the snapshot.
+func RestoreFailed(at metav1.Time, reason xpv1.ConditionReason) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeFailed,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: at,
+		Reason:             reason,
+	}
+}
+
+// BackupRestoreJobParameters are the configurable fields of a
+// BackupRestoreJob.
+type BackupRestoreJobParameters struct {
+	// OrganizationRef references the Organization that owns the project. The
+	// provider manages the restore job with the API key of the organization.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// ProjectID is the ID of the Atlas project of the source cluster.
+	ProjectID string `json:"projectID"`
+
+	// ClusterName is the name of the Atlas cluster the snapshot was taken
+	// of.
+	ClusterName string `json:"clusterName"`
+
+	// DeliveryType is how the snapshot is restored: automated restores a
+	// snapshot to the target cluster, pointInTime restores the target
+	// cluster to PointInTimeUTCSeconds and download creates a link to
+	// download the snapshot.
+	// +kubebuilder:validation:Enum=automated;pointInTime;download
+	DeliveryType string `json:"deliveryType"`
+
+	// SnapshotRef references the BackupSnapshot to restore.
+	// +optional
+	SnapshotRef *xpv1.Reference `json:"snapshotRef,omitempty"`
+
+	// SnapshotID is the ID of the snapshot to restore. Either SnapshotRef or
+	// SnapshotID is required for automated and download restores.
+	// +optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// PointInTimeUTCSeconds is the time to restore the target cluster to.
+	// Required for pointInTime restores.
+	// +optional
+	PointInTimeUTCSeconds *int64 `json:"pointInTimeUTCSeconds,omitempty"`
+
+	// TargetProjectID is the ID of the project of the target cluster.
+	// Defaults to ProjectID.
+	// +optional
+	TargetProjectID string `json:"targetProjectID,omitempty"`
+
+	// TargetClusterName is the name of the cluster to restore to. Required
+	// for automated and pointInTime restores.
+	// +optional
+	TargetClusterName string `json:"targetClusterName,omitempty"`
+}
+
+// BackupRestoreJobObservation are the observable fields of a
+// BackupRestoreJob.
+type BackupRestoreJobObservation struct {
+	ID         string       `json:"id,omitempty"`
+	SnapshotID string       `json:"snapshotID,omitempty"`
+	Timestamp  *metav1.Time `json:"timestamp,omitempty"`
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+	Cancelled  bool         `json:"cancelled,omitempty"`
+	Expired    bool         `json:"expired,omitempty"`
+	Failed     bool         `json:"failed,omitempty"`
+	// DeliveryURLs to download the snapshot from for download restores.
+	DeliveryURLs []string `json:"deliveryURLs,omitempty"`
+}
+
+// A BackupRestoreJobSpec defines the desired state of a BackupRestoreJob.
+type BackupRestoreJobSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackupRestoreJobParameters `json:"forProvider"`
+}
+
+// A BackupRestoreJobStatus represents the observed state of a
+// BackupRestoreJob.
+type BackupRestoreJobStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackupRestoreJobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BackupRestoreJob restores a cloud backup snapshot of a MongoDB Atlas
+// cluster. The job is started once; changes to its spec are ignored. The
+// Complete and Failed conditions report how and when it finished.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="COMPLETE",type="string",JSONPath=".status.conditions[?(@.type=='Complete')].status"
+// +kubebuilder:printcolumn:name="FAILED",type="string",JSONPath=".status.conditions[?(@.type=='Failed')].status"
+// +kubebuilder:printcolumn:name="FINISHED",type="date",JSONPath=".status.atProvider.finishedAt"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type BackupRestoreJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupRestoreJobSpec   `json:"spec"`
+	Status BackupRestoreJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupRestoreJobList contains a list of BackupRestoreJob
+type BackupRestoreJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupRestoreJob `json:"items"`
+}
+
+// BackupRestoreJob type metadata.
+var (
+	BackupRestoreJobKind             = reflect.TypeOf(BackupRestoreJob{}).Name()
+	BackupRestoreJobGroupKind        = schema.GroupKind{Group: Group, Kind: BackupRestoreJobKind}.String()
+	BackupRestoreJobKindAPIVersion   = BackupRestoreJobKind + "." + SchemeGroupVersion.String()
+	BackupRestoreJobGroupVersionKind = SchemeGroupVersion.WithKind(BackupRestoreJobKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BackupRestoreJob{}, &BackupRestoreJobList{})
+}
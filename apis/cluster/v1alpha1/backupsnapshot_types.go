@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BackupSnapshotParameters are the configurable fields of a BackupSnapshot.
+type BackupSnapshotParameters struct {
+	// OrganizationRef references the Organization that owns the project. The
+	// provider manages the snapshot with the API key of the organization.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// ProjectID is the ID of the Atlas project of the cluster.
+	ProjectID string `json:"projectID"`
+
+	// ClusterName is the name of the Atlas cluster to take the snapshot of.
+	ClusterName string `json:"clusterName"`
+
+	// Description of the snapshot.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// RetentionInDays is the number of days after its creation the snapshot
+	// expires.
+	// +kubebuilder:validation:Minimum=1
+	RetentionInDays int `json:"retentionInDays"`
+}
+
+// BackupSnapshotObservation are the observable fields of a BackupSnapshot.
+type BackupSnapshotObservation struct {
+	ID               string       `json:"id,omitempty"`
+	Status           string       `json:"status,omitempty"`
+	SnapshotType     string       `json:"snapshotType,omitempty"`
+	CreatedAt        *metav1.Time `json:"createdAt,omitempty"`
+	ExpiresAt        *metav1.Time `json:"expiresAt,omitempty"`
+	StorageSizeBytes int64        `json:"storageSizeBytes,omitempty"`
+	MongodVersion    string       `json:"mongodVersion,omitempty"`
+}
+
+// A BackupSnapshotSpec defines the desired state of a BackupSnapshot.
+type BackupSnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackupSnapshotParameters `json:"forProvider"`
+}
+
+// A BackupSnapshotStatus represents the observed state of a BackupSnapshot.
+type BackupSnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackupSnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BackupSnapshot is an on-demand cloud backup snapshot of a MongoDB Atlas
+// cluster. It is ready once the snapshot completed.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="EXPIRES",type="date",JSONPath=".status.atProvider.expiresAt"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type BackupSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupSnapshotSpec   `json:"spec"`
+	Status BackupSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupSnapshotList contains a list of BackupSnapshot
+type BackupSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupSnapshot `json:"items"`
+}
+
+// BackupSnapshot type metadata.
+var (
+	BackupSnapshotKind             = reflect.TypeOf(BackupSnapshot{}).Name()
+	BackupSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: BackupSnapshotKind}.String()
+	BackupSnapshotKindAPIVersion   = BackupSnapshotKind + "." + SchemeGroupVersion.String()
+	BackupSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(BackupSnapshotKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BackupSnapshot{}, &BackupSnapshotList{})
+}
@@ -0,0 +1,187 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A BackupPolicyItem takes snapshots at an interval and keeps them for a
+// retention period.
+type BackupPolicyItem struct {
+	// FrequencyInterval is the interval between snapshots: hours for hourly
+	// items, days for daily items, the day of the week (1-7) for weekly items
+	// and the day of the month (1-28, or 40 for the last day) for monthly
+	// items.
+	FrequencyInterval int `json:"frequencyInterval"`
+
+	// RetentionUnit is the unit of the retention period.
+	// +kubebuilder:validation:Enum=days;weeks;months;years
+	RetentionUnit string `json:"retentionUnit"`
+
+	// RetentionValue is the retention period in RetentionUnit.
+	// +kubebuilder:validation:Minimum=1
+	RetentionValue int `json:"retentionValue"`
+}
+
+// A BackupCopySetting copies snapshots to another region.
+type BackupCopySetting struct {
+	// CloudProvider of the region the snapshots are copied to.
+	// +kubebuilder:validation:Enum=AWS;GCP;AZURE
+	CloudProvider string `json:"cloudProvider"`
+
+	// RegionName is the Atlas name of the region, e.g. EU_WEST_1.
+	RegionName string `json:"regionName"`
+
+	// ReplicationSpecID is the ID of the replication spec of the cluster
+	// whose snapshots are copied.
+	ReplicationSpecID string `json:"replicationSpecID"`
+
+	// ShouldCopyOplogs copies the oplogs required for point in time restores.
+	// +optional
+	ShouldCopyOplogs bool `json:"shouldCopyOplogs,omitempty"`
+
+	// Frequencies of the snapshots that are copied, e.g. DAILY or ON_DEMAND.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Frequencies []string `json:"frequencies"`
+}
+
+// A BackupExport exports snapshots to an export bucket.
+type BackupExport struct {
+	// ExportBucketID is the ID of the Atlas export bucket.
+	ExportBucketID string `json:"exportBucketID"`
+
+	// FrequencyType of the snapshots that are exported.
+	// +kubebuilder:validation:Enum=daily;weekly;monthly;yearly
+	FrequencyType string `json:"frequencyType"`
+}
+
+// CloudBackupScheduleParameters are the configurable fields of a
+// CloudBackupSchedule.
+type CloudBackupScheduleParameters struct {
+	// OrganizationRef references the Organization that owns the project. The
+	// provider manages the schedule with the API key of the organization.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// ProjectID is the ID of the Atlas project of the cluster.
+	ProjectID string `json:"projectID"`
+
+	// ClusterName is the name of the Atlas cluster. Cloud backups must be
+	// enabled on the cluster.
+	ClusterName string `json:"clusterName"`
+
+	// ReferenceHourOfDay is the UTC hour of the day snapshots are taken at.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	// +optional
+	ReferenceHourOfDay *int `json:"referenceHourOfDay,omitempty"`
+
+	// ReferenceMinuteOfHour is the UTC minute of the hour snapshots are taken
+	// at.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	// +optional
+	ReferenceMinuteOfHour *int `json:"referenceMinuteOfHour,omitempty"`
+
+	// RestoreWindowDays is the number of days back a point in time restore
+	// can go to.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RestoreWindowDays *int `json:"restoreWindowDays,omitempty"`
+
+	// Hourly policy items take snapshots every FrequencyInterval hours.
+	// +optional
+	Hourly []BackupPolicyItem `json:"hourly,omitempty"`
+
+	// Daily policy items take a snapshot every day.
+	// +optional
+	Daily []BackupPolicyItem `json:"daily,omitempty"`
+
+	// Weekly policy items take a snapshot on a day of the week.
+	// +optional
+	Weekly []BackupPolicyItem `json:"weekly,omitempty"`
+
+	// Monthly policy items take a snapshot on a day of the month.
+	// +optional
+	Monthly []BackupPolicyItem `json:"monthly,omitempty"`
+
+	// CopySettings copy snapshots to other regions.
+	// +optional
+	CopySettings []BackupCopySetting `json:"copySettings,omitempty"`
+
+	// Export enables the automatic export of snapshots.
+	// +optional
+	Export *BackupExport `json:"export,omitempty"`
+
+	// UseOrgAndGroupNamesInExportPrefix uses the organization and project
+	// names instead of their IDs in the path of exported snapshots.
+	// +optional
+	UseOrgAndGroupNamesInExportPrefix bool `json:"useOrgAndGroupNamesInExportPrefix,omitempty"`
+}
+
+// CloudBackupScheduleObservation are the observable fields of a
+// CloudBackupSchedule.
+type CloudBackupScheduleObservation struct {
+	ClusterID    string       `json:"clusterID,omitempty"`
+	PolicyID     string       `json:"policyID,omitempty"`
+	NextSnapshot *metav1.Time `json:"nextSnapshot,omitempty"`
+}
+
+// A CloudBackupScheduleSpec defines the desired state of a
+// CloudBackupSchedule.
+type CloudBackupScheduleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudBackupScheduleParameters `json:"forProvider"`
+}
+
+// A CloudBackupScheduleStatus represents the observed state of a
+// CloudBackupSchedule.
+type CloudBackupScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudBackupScheduleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudBackupSchedule is the cloud backup schedule and snapshot policy of a
+// MongoDB Atlas cluster. Changes made outside of Crossplane are reverted.
+// Deleting it removes all policy items of the cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="NEXT-SNAPSHOT",type="date",JSONPath=".status.atProvider.nextSnapshot"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type CloudBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudBackupScheduleSpec   `json:"spec"`
+	Status CloudBackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudBackupScheduleList contains a list of CloudBackupSchedule
+type CloudBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudBackupSchedule `json:"items"`
+}
+
+// CloudBackupSchedule type metadata.
+var (
+	CloudBackupScheduleKind             = reflect.TypeOf(CloudBackupSchedule{}).Name()
+	CloudBackupScheduleGroupKind        = schema.GroupKind{Group: Group, Kind: CloudBackupScheduleKind}.String()
+	CloudBackupScheduleKindAPIVersion   = CloudBackupScheduleKind + "." + SchemeGroupVersion.String()
+	CloudBackupScheduleGroupVersionKind = SchemeGroupVersion.WithKind(CloudBackupScheduleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudBackupSchedule{}, &CloudBackupScheduleList{})
+}
@@ -0,0 +1,98 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FlexClusterParameters are the configurable fields of a FlexCluster.
+type FlexClusterParameters struct {
+	// OrganizationRef references the Organization that owns the project. The
+	// provider manages the cluster with the API key of the organization.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// ProjectID is the ID of the Atlas project of the cluster.
+	ProjectID string `json:"projectID"`
+
+	// Name of the cluster. Defaults to the name of the resource.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ProviderName is the cloud provider the cluster runs on.
+	// +kubebuilder:validation:Enum=AWS;GCP;AZURE
+	ProviderName string `json:"providerName"`
+
+	// RegionName is the Atlas name of the region, e.g. EU_CENTRAL_1.
+	RegionName string `json:"regionName"`
+
+	// TerminationProtectionEnabled prevents the cluster from being deleted.
+	// Disable it before deleting the resource.
+	// +optional
+	TerminationProtectionEnabled bool `json:"terminationProtectionEnabled,omitempty"`
+}
+
+// FlexClusterObservation are the observable fields of a FlexCluster.
+type FlexClusterObservation struct {
+	ID             string `json:"id,omitempty"`
+	StateName      string `json:"stateName,omitempty"`
+	MongoDBVersion string `json:"mongoDBVersion,omitempty"`
+	// BackupEnabled reports whether Atlas takes daily snapshots of the
+	// cluster. Backups of flex clusters cannot be configured.
+	BackupEnabled bool `json:"backupEnabled,omitempty"`
+}
+
+// A FlexClusterSpec defines the desired state of a FlexCluster.
+type FlexClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FlexClusterParameters `json:"forProvider"`
+}
+
+// A FlexClusterStatus represents the observed state of a FlexCluster.
+type FlexClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FlexClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FlexCluster is a MongoDB Atlas flex cluster. Its SRV connection string is
+// published as the srvConnectionString connection detail.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.stateName"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.regionName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type FlexCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlexClusterSpec   `json:"spec"`
+	Status FlexClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FlexClusterList contains a list of FlexCluster
+type FlexClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlexCluster `json:"items"`
+}
+
+// FlexCluster type metadata.
+var (
+	FlexClusterKind             = reflect.TypeOf(FlexCluster{}).Name()
+	FlexClusterGroupKind        = schema.GroupKind{Group: Group, Kind: FlexClusterKind}.String()
+	FlexClusterKindAPIVersion   = FlexClusterKind + "." + SchemeGroupVersion.String()
+	FlexClusterGroupVersionKind = SchemeGroupVersion.WithKind(FlexClusterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FlexCluster{}, &FlexClusterList{})
+}
@@ -0,0 +1,104 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ServerlessInstanceParameters are the configurable fields of a
+// ServerlessInstance.
+type ServerlessInstanceParameters struct {
+	// OrganizationRef references the Organization that owns the project. The
+	// provider manages the instance with the API key of the organization.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// ProjectID is the ID of the Atlas project of the instance.
+	ProjectID string `json:"projectID"`
+
+	// Name of the instance. Defaults to the name of the resource.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ProviderName is the cloud provider the instance runs on.
+	// +kubebuilder:validation:Enum=AWS;GCP;AZURE
+	ProviderName string `json:"providerName"`
+
+	// RegionName is the Atlas name of the region, e.g. EU_CENTRAL_1.
+	RegionName string `json:"regionName"`
+
+	// TerminationProtectionEnabled prevents the instance from being deleted.
+	// Disable it before deleting the resource.
+	// +optional
+	TerminationProtectionEnabled bool `json:"terminationProtectionEnabled,omitempty"`
+
+	// ContinuousBackupEnabled enables continuous cloud backups instead of
+	// basic backups.
+	// +optional
+	ContinuousBackupEnabled bool `json:"continuousBackupEnabled,omitempty"`
+}
+
+// ServerlessInstanceObservation are the observable fields of a
+// ServerlessInstance.
+type ServerlessInstanceObservation struct {
+	ID             string `json:"id,omitempty"`
+	StateName      string `json:"stateName,omitempty"`
+	MongoDBVersion string `json:"mongoDBVersion,omitempty"`
+}
+
+// A ServerlessInstanceSpec defines the desired state of a ServerlessInstance.
+type ServerlessInstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServerlessInstanceParameters `json:"forProvider"`
+}
+
+// A ServerlessInstanceStatus represents the observed state of a
+// ServerlessInstance.
+type ServerlessInstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServerlessInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServerlessInstance is a MongoDB Atlas serverless instance. Its SRV
+// connection string is published as the srvConnectionString connection
+// detail.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.stateName"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.regionName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type ServerlessInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServerlessInstanceSpec   `json:"spec"`
+	Status ServerlessInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServerlessInstanceList contains a list of ServerlessInstance
+type ServerlessInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServerlessInstance `json:"items"`
+}
+
+// ServerlessInstance type metadata.
+var (
+	ServerlessInstanceKind             = reflect.TypeOf(ServerlessInstance{}).Name()
+	ServerlessInstanceGroupKind        = schema.GroupKind{Group: Group, Kind: ServerlessInstanceKind}.String()
+	ServerlessInstanceKindAPIVersion   = ServerlessInstanceKind + "." + SchemeGroupVersion.String()
+	ServerlessInstanceGroupVersionKind = SchemeGroupVersion.WithKind(ServerlessInstanceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServerlessInstance{}, &ServerlessInstanceList{})
+}
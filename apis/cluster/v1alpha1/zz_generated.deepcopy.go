@@ -0,0 +1,778 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupCopySetting) DeepCopyInto(out *BackupCopySetting) {
+	*out = *in
+	if in.Frequencies != nil {
+		in, out := &in.Frequencies, &out.Frequencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupCopySetting.
+func (in *BackupCopySetting) DeepCopy() *BackupCopySetting {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupCopySetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupExport) DeepCopyInto(out *BackupExport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupExport.
+func (in *BackupExport) DeepCopy() *BackupExport {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPolicyItem) DeepCopyInto(out *BackupPolicyItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPolicyItem.
+func (in *BackupPolicyItem) DeepCopy() *BackupPolicyItem {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPolicyItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRestoreJob) DeepCopyInto(out *BackupRestoreJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRestoreJob.
+func (in *BackupRestoreJob) DeepCopy() *BackupRestoreJob {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRestoreJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupRestoreJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRestoreJobList) DeepCopyInto(out *BackupRestoreJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupRestoreJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRestoreJobList.
+func (in *BackupRestoreJobList) DeepCopy() *BackupRestoreJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRestoreJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupRestoreJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRestoreJobObservation) DeepCopyInto(out *BackupRestoreJobObservation) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DeliveryURLs != nil {
+		in, out := &in.DeliveryURLs, &out.DeliveryURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRestoreJobObservation.
+func (in *BackupRestoreJobObservation) DeepCopy() *BackupRestoreJobObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRestoreJobObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRestoreJobParameters) DeepCopyInto(out *BackupRestoreJobParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+	if in.SnapshotRef != nil {
+		in, out := &in.SnapshotRef, &out.SnapshotRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PointInTimeUTCSeconds != nil {
+		in, out := &in.PointInTimeUTCSeconds, &out.PointInTimeUTCSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRestoreJobParameters.
+func (in *BackupRestoreJobParameters) DeepCopy() *BackupRestoreJobParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRestoreJobParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRestoreJobSpec) DeepCopyInto(out *BackupRestoreJobSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRestoreJobSpec.
+func (in *BackupRestoreJobSpec) DeepCopy() *BackupRestoreJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRestoreJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRestoreJobStatus) DeepCopyInto(out *BackupRestoreJobStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRestoreJobStatus.
+func (in *BackupRestoreJobStatus) DeepCopy() *BackupRestoreJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRestoreJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSnapshot) DeepCopyInto(out *BackupSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSnapshot.
+func (in *BackupSnapshot) DeepCopy() *BackupSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSnapshotList) DeepCopyInto(out *BackupSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSnapshotList.
+func (in *BackupSnapshotList) DeepCopy() *BackupSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSnapshotObservation) DeepCopyInto(out *BackupSnapshotObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSnapshotObservation.
+func (in *BackupSnapshotObservation) DeepCopy() *BackupSnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSnapshotParameters) DeepCopyInto(out *BackupSnapshotParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSnapshotParameters.
+func (in *BackupSnapshotParameters) DeepCopy() *BackupSnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSnapshotSpec) DeepCopyInto(out *BackupSnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSnapshotSpec.
+func (in *BackupSnapshotSpec) DeepCopy() *BackupSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSnapshotStatus) DeepCopyInto(out *BackupSnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSnapshotStatus.
+func (in *BackupSnapshotStatus) DeepCopy() *BackupSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudBackupSchedule) DeepCopyInto(out *CloudBackupSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudBackupSchedule.
+func (in *CloudBackupSchedule) DeepCopy() *CloudBackupSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudBackupSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudBackupSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudBackupScheduleList) DeepCopyInto(out *CloudBackupScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudBackupSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudBackupScheduleList.
+func (in *CloudBackupScheduleList) DeepCopy() *CloudBackupScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudBackupScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudBackupScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudBackupScheduleObservation) DeepCopyInto(out *CloudBackupScheduleObservation) {
+	*out = *in
+	if in.NextSnapshot != nil {
+		in, out := &in.NextSnapshot, &out.NextSnapshot
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudBackupScheduleObservation.
+func (in *CloudBackupScheduleObservation) DeepCopy() *CloudBackupScheduleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudBackupScheduleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudBackupScheduleParameters) DeepCopyInto(out *CloudBackupScheduleParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+	if in.ReferenceHourOfDay != nil {
+		in, out := &in.ReferenceHourOfDay, &out.ReferenceHourOfDay
+		*out = new(int)
+		**out = **in
+	}
+	if in.ReferenceMinuteOfHour != nil {
+		in, out := &in.ReferenceMinuteOfHour, &out.ReferenceMinuteOfHour
+		*out = new(int)
+		**out = **in
+	}
+	if in.RestoreWindowDays != nil {
+		in, out := &in.RestoreWindowDays, &out.RestoreWindowDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.Hourly != nil {
+		in, out := &in.Hourly, &out.Hourly
+		*out = make([]BackupPolicyItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.Daily != nil {
+		in, out := &in.Daily, &out.Daily
+		*out = make([]BackupPolicyItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.Weekly != nil {
+		in, out := &in.Weekly, &out.Weekly
+		*out = make([]BackupPolicyItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.Monthly != nil {
+		in, out := &in.Monthly, &out.Monthly
+		*out = make([]BackupPolicyItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.CopySettings != nil {
+		in, out := &in.CopySettings, &out.CopySettings
+		*out = make([]BackupCopySetting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(BackupExport)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudBackupScheduleParameters.
+func (in *CloudBackupScheduleParameters) DeepCopy() *CloudBackupScheduleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudBackupScheduleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudBackupScheduleSpec) DeepCopyInto(out *CloudBackupScheduleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudBackupScheduleSpec.
+func (in *CloudBackupScheduleSpec) DeepCopy() *CloudBackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudBackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudBackupScheduleStatus) DeepCopyInto(out *CloudBackupScheduleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudBackupScheduleStatus.
+func (in *CloudBackupScheduleStatus) DeepCopy() *CloudBackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudBackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlexCluster) DeepCopyInto(out *FlexCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlexCluster.
+func (in *FlexCluster) DeepCopy() *FlexCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(FlexCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlexCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlexClusterList) DeepCopyInto(out *FlexClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FlexCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlexClusterList.
+func (in *FlexClusterList) DeepCopy() *FlexClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(FlexClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlexClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlexClusterObservation) DeepCopyInto(out *FlexClusterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlexClusterObservation.
+func (in *FlexClusterObservation) DeepCopy() *FlexClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FlexClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlexClusterParameters) DeepCopyInto(out *FlexClusterParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlexClusterParameters.
+func (in *FlexClusterParameters) DeepCopy() *FlexClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FlexClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlexClusterSpec) DeepCopyInto(out *FlexClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlexClusterSpec.
+func (in *FlexClusterSpec) DeepCopy() *FlexClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlexClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlexClusterStatus) DeepCopyInto(out *FlexClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlexClusterStatus.
+func (in *FlexClusterStatus) DeepCopy() *FlexClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlexClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessInstance) DeepCopyInto(out *ServerlessInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessInstance.
+func (in *ServerlessInstance) DeepCopy() *ServerlessInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerlessInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServerlessInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessInstanceList) DeepCopyInto(out *ServerlessInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServerlessInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessInstanceList.
+func (in *ServerlessInstanceList) DeepCopy() *ServerlessInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerlessInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServerlessInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessInstanceObservation) DeepCopyInto(out *ServerlessInstanceObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessInstanceObservation.
+func (in *ServerlessInstanceObservation) DeepCopy() *ServerlessInstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerlessInstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessInstanceParameters) DeepCopyInto(out *ServerlessInstanceParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessInstanceParameters.
+func (in *ServerlessInstanceParameters) DeepCopy() *ServerlessInstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerlessInstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessInstanceSpec) DeepCopyInto(out *ServerlessInstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessInstanceSpec.
+func (in *ServerlessInstanceSpec) DeepCopy() *ServerlessInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerlessInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerlessInstanceStatus) DeepCopyInto(out *ServerlessInstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerlessInstanceStatus.
+func (in *ServerlessInstanceStatus) DeepCopy() *ServerlessInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerlessInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
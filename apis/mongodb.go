@@ -20,8 +20,10 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	clusterv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
 	connectivityv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/connectivity/v1alpha1"
 	organizationv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	projectv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
 	providerv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
 )
 
@@ -31,6 +33,8 @@ func init() {
 		providerv1alpha1.SchemeBuilder.AddToScheme,
 		organizationv1alpha1.SchemeBuilder.AddToScheme,
 		connectivityv1alpha1.SchemeBuilder.AddToScheme,
+		projectv1alpha1.SchemeBuilder.AddToScheme,
+		clusterv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -2,7 +2,9 @@ package v1alpha1
 
 import (
 	"reflect"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -15,38 +17,226 @@ type AWSSecretsManagerReference struct {
 	Region string `json:"region"`
 
 	// SecretName is just the short org identifier (e.g., "test-org").
-	// The controller will prepend "product/mongodb/".
+	// The controller will prepend "product/mongodb/", or apply the secret
+	// naming of the ProviderConfig.
 	// If omitted, defaults to metadata.name.
 	SecretName *string `json:"secretName,omitempty"`
 
 	// AWS KMS Key ID for encryption (optional).
 	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// SecretDeletionPolicy controls what happens to the secret when the
+	// organization is deleted. Retain keeps the secret and tags it as
+	// orphaned, Delete (default) schedules deletion after the recovery window
+	// and ForceDelete deletes it immediately without recovery.
+	// +kubebuilder:validation:Enum=Retain;Delete;ForceDelete
+	// +kubebuilder:default=Delete
+	// +optional
+	SecretDeletionPolicy string `json:"secretDeletionPolicy,omitempty"`
+
+	// RecoveryWindowInDays is the number of days a secret deleted with the
+	// Delete policy can still be restored. Defaults to 7.
+	// +kubebuilder:validation:Minimum=7
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	RecoveryWindowInDays *int64 `json:"recoveryWindowInDays,omitempty"`
+
+	// Tags are set on the secret next to the tags managed by the provider,
+	// which include the name and UID of this resource. Other tags are
+	// removed from the secret.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// ResourcePolicy is a JSON resource policy attached to the secret, e.g.
+	// to restrict who can read the organization API key. Removing it deletes
+	// the policy attached to the secret.
+	// +optional
+	ResourcePolicy *string `json:"resourcePolicy,omitempty"`
+
+	// ReplicaRegions are additional regions the secret is replicated to.
+	// The KMS key of an existing replica can only be changed by removing
+	// and adding its region again.
+	// +listType=map
+	// +listMapKey=region
+	// +optional
+	ReplicaRegions []SecretReplicaRegion `json:"replicaRegions,omitempty"`
+}
+
+// SecretReplicaRegion is a region a secret is replicated to.
+type SecretReplicaRegion struct {
+	// Region to replicate the secret to.
+	Region string `json:"region"`
+
+	// KMSKeyID used to encrypt the replica. Defaults to the AWS managed key
+	// of the region.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+}
+
+// SecretReplicationStatus is the replication status of a secret in a replica
+// region.
+type SecretReplicationStatus struct {
+	Region string `json:"region"`
+	// Status is InSync, InProgress or Failed.
+	Status        string `json:"status,omitempty"`
+	StatusMessage string `json:"statusMessage,omitempty"`
+	KMSKeyID      string `json:"kmsKeyID,omitempty"`
 }
 
 // OrganizationParameters are the configurable fields of an Organization.
 type OrganizationParameters struct {
-	APIKey           OrganizationAPIKey         `json:"apiKey"`
-	OwnerID          string                     `json:"ownerID"`
-	AWSSecretsConfig AWSSecretsManagerReference `json:"awsSecretsConfig"`
+	// APIKey is the initial API key created with the organization.
+	// Required unless managementPolicies only allow observing.
+	// +optional
+	APIKey *OrganizationAPIKey `json:"apiKey,omitempty"`
+
+	// OwnerID is the Atlas user ID of the organization owner.
+	// Required unless managementPolicies only allow observing.
+	// +optional
+	OwnerID string `json:"ownerID,omitempty"`
+
+	// AWSSecretsConfig configures the AWS Secrets Manager secret holding the
+	// API key. Required unless another credentialSink is used.
+	// +optional
+	AWSSecretsConfig AWSSecretsManagerReference `json:"awsSecretsConfig,omitempty"`
+
+	// CredentialSink selects where the API key is written: AWSSecretsManager
+	// (default) uses awsSecretsConfig, Vault uses vaultConfig.
+	// +kubebuilder:validation:Enum=AWSSecretsManager;Vault
+	// +kubebuilder:default=AWSSecretsManager
+	// +optional
+	CredentialSink string `json:"credentialSink,omitempty"`
+
+	// VaultConfig configures the Vault KV v2 secret holding the API key.
+	// +optional
+	VaultConfig *VaultReference `json:"vaultConfig,omitempty"`
+
+	// SecretPayload controls the content of the secret holding the API key.
+	// It is applied when the secret is written.
+	// +optional
+	SecretPayload *SecretPayload `json:"secretPayload,omitempty"`
+
+	// ChildDeletionPolicy controls what happens to projects that still exist
+	// in the organization when it is deleted. Block (default) delays deletion
+	// until all projects are gone, Cascade deletes the projects first.
+	// +kubebuilder:validation:Enum=Block;Cascade
+	// +kubebuilder:default=Block
+	// +optional
+	ChildDeletionPolicy string `json:"childDeletionPolicy,omitempty"`
+
+	// Settings are the security settings of the organization. Settings that
+	// are not set are left as they are in Atlas.
+	// +optional
+	Settings *OrganizationSettings `json:"settings,omitempty"`
+}
+
+// OrganizationSettings are the security settings of an organization.
+type OrganizationSettings struct {
+	// APIAccessListRequired requires API requests to originate from an IP
+	// address on the access list of the API key.
+	// +optional
+	APIAccessListRequired *bool `json:"apiAccessListRequired,omitempty"`
+
+	// MultiFactorAuthRequired requires users of the organization to set up
+	// multi-factor authentication.
+	// +optional
+	MultiFactorAuthRequired *bool `json:"multiFactorAuthRequired,omitempty"`
+
+	// RestrictEmployeeAccess blocks MongoDB support staff from accessing the
+	// infrastructure of the organization without explicit permission.
+	// +optional
+	RestrictEmployeeAccess *bool `json:"restrictEmployeeAccess,omitempty"`
+
+	// GenAIFeaturesEnabled enables generative AI features in Atlas.
+	// +optional
+	GenAIFeaturesEnabled *bool `json:"genAIFeaturesEnabled,omitempty"`
+
+	// SecurityContact is the email address notified of security issues of
+	// the organization.
+	// +optional
+	SecurityContact *string `json:"securityContact,omitempty"`
+}
+
+// VaultReference defines where in Vault the API key of an organization is
+// written.
+type VaultReference struct {
+	// StoreConfigRef references the StoreConfig of type Vault with the
+	// server, KV v2 mount path and auth method to use.
+	StoreConfigRef xpv1.Reference `json:"storeConfigRef"`
+
+	// Path of the secret below the mount path. Defaults to the secret name
+	// derived for AWS Secrets Manager, e.g. product/mongodb/<org>.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// SecretDeletionPolicy controls what happens to the secret when the
+	// organization is deleted. Retain keeps the secret, Delete (default)
+	// deletes its latest version, which can be undeleted, and ForceDelete
+	// removes all of its versions.
+	// +kubebuilder:validation:Enum=Retain;Delete;ForceDelete
+	// +kubebuilder:default=Delete
+	// +optional
+	SecretDeletionPolicy string `json:"secretDeletionPolicy,omitempty"`
+}
+
+// SecretPayload configures the keys of the secret holding the organization
+// API key. The publicKey and privateKey keys are always stored.
+type SecretPayload struct {
+	// Fields lists the fields stored next to the key pair. Defaults to all
+	// of orgId, apiKeyId, roles, baseUrl and createdAt.
+	// +kubebuilder:validation:items:Enum=orgId;apiKeyId;roles;baseUrl;createdAt
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+
+	// KeyNames renames keys of the secret, e.g. {"publicKey": "username",
+	// "privateKey": "password"}. Fields not listed keep their default name.
+	// +optional
+	KeyNames map[string]string `json:"keyNames,omitempty"`
 }
 
 // OrganizationAPIKey defines the initial API key details.
 type OrganizationAPIKey struct {
-	Description string   `json:"description"`
-	Roles       []string `json:"roles"`
+	// Description of the API key. Only used on creation.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Roles of the API key. Required on creation.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// AccessList are the CIDR blocks or IP addresses requests with the API
+	// key may originate from. The provider manages the organization with the
+	// key, include its egress addresses. Not managed when unset.
+	// +listType=set
+	// +optional
+	AccessList []string `json:"accessList,omitempty"`
 }
 
 // OrganizationObservation are the observable fields of an Organization.
 type OrganizationObservation struct {
 	OrgID      string       `json:"orgID,omitempty"`
 	OrgName    string       `json:"orgName,omitempty"`
-	SecretName string       `json:"secretName,omitempty"` // expanded name product/mongodb/<org>
+	SecretName string       `json:"secretName,omitempty"` // expanded name, e.g. product/mongodb/<org>
 	SecretARN  string       `json:"secretARN,omitempty"`
-	KMSKeyID   string       `json:"kmsKeyID,omitempty"`
+	KMSKeyID   string       `json:"kmsKeyID,omitempty"` // resolved key ARN
 	CreatedAt  *metav1.Time `json:"createdAt,omitempty"`
-	// ADD: Deletion state tracking
-	State      *string      `json:"state,omitempty"`       // PENDING, ACTIVE, DELETING, DELETED
-	DeletedAt  *metav1.Time `json:"deletedAt,omitempty"`   // When deletion started
+	// State is the lifecycle state of the organization: PENDING, ACTIVE,
+	// DELETING or DELETED.
+	State *string `json:"state,omitempty"`
+	// ReplicationStatus reports the replicas of the secret per region.
+	ReplicationStatus []SecretReplicationStatus `json:"replicationStatus,omitempty"`
+	// DeletedAt is when deletion of the organization was requested from
+	// Atlas. The resource is kept until Atlas no longer returns it.
+	DeletedAt *metav1.Time `json:"deletedAt,omitempty"`
+	// APIKeyID is the ID of the API key created with the organization.
+	APIKeyID string `json:"apiKeyID,omitempty"`
+	// APIKeyAccessList are the CIDR blocks of the access list of the API
+	// key. They are only observed when spec.forProvider.apiKey.accessList is
+	// set.
+	APIKeyAccessList []string `json:"apiKeyAccessList,omitempty"`
+	// Settings are the security settings of the organization in Atlas. They
+	// are only observed when spec.forProvider.settings is set.
+	Settings *OrganizationSettings `json:"settings,omitempty"`
 }
 
 // OrganizationSpec defines the desired state of an Organization.
@@ -72,6 +262,11 @@ type OrganizationStatus struct {
 //   - "Orphan": Organization is preserved in MongoDB Atlas
 // - Finalizers ensure proper cleanup sequence and prevent premature deletion
 // - If child resources (Projects, Clusters) exist, deletion will be delayed until they are deleted
+//   and the DeletionBlocked condition lists them. Set childDeletionPolicy to Cascade to delete
+//   empty projects together with the organization.
+//
+// Existing organizations can be imported by setting the crossplane.io/external-name
+// annotation to the organization ID together with managementPolicies: ["Observe"].
 //
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
@@ -114,8 +309,54 @@ const (
 	OrganizationStateActive   = "ACTIVE"
 	OrganizationStateDeleting = "DELETING"
 	OrganizationStateDeleted  = "DELETED"
+
+	// Credential sinks
+	CredentialSinkAWSSecretsManager = "AWSSecretsManager"
+	CredentialSinkVault             = "Vault"
+
+	// Child deletion policies
+	ChildDeletionPolicyBlock   = "Block"
+	ChildDeletionPolicyCascade = "Cascade"
+
+	// Secret deletion policies
+	SecretDeletionPolicyRetain      = "Retain"
+	SecretDeletionPolicyDelete      = "Delete"
+	SecretDeletionPolicyForceDelete = "ForceDelete"
 )
 
+// TypeDeletionBlocked indicates whether deletion of an organization is held
+// back by child resources.
+const TypeDeletionBlocked xpv1.ConditionType = "DeletionBlocked"
+
+// Reasons a deletion is or is not blocked.
+const (
+	ReasonChildProjectsExist xpv1.ConditionReason = "ChildProjectsExist"
+	ReasonNoChildProjects    xpv1.ConditionReason = "NoChildProjects"
+)
+
+// DeletionBlocked returns a condition that indicates deletion of the
+// organization is waiting for the supplied projects to be deleted.
+func DeletionBlocked(projects []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonChildProjectsExist,
+		Message:            "organization still contains projects: " + strings.Join(projects, ", "),
+	}
+}
+
+// DeletionUnblocked returns a condition that indicates no child resources
+// prevent deletion of the organization.
+func DeletionUnblocked() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoChildProjects,
+	}
+}
+
 func init() {
 	SchemeBuilder.Register(&Organization{}, &OrganizationList{})
 }
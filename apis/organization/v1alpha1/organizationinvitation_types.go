@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OrganizationInvitationParameters are the configurable fields of an
+// OrganizationInvitation.
+type OrganizationInvitationParameters struct {
+	// OrganizationRef references the Organization the user is invited to.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// Username is the email address of the invited user.
+	Username string `json:"username"`
+
+	// Roles of the user in the organization, e.g. ORG_MEMBER.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Roles []string `json:"roles"`
+
+	// TeamRefs references the Teams the user joins when accepting the
+	// invitation.
+	// +optional
+	TeamRefs []xpv1.Reference `json:"teamRefs,omitempty"`
+}
+
+// OrganizationInvitationObservation are the observable fields of an
+// OrganizationInvitation.
+type OrganizationInvitationObservation struct {
+	ID              string       `json:"id,omitempty"`
+	OrgID           string       `json:"orgID,omitempty"`
+	InviterUsername string       `json:"inviterUsername,omitempty"`
+	ExpiresAt       *metav1.Time `json:"expiresAt,omitempty"`
+	// Accepted is true once the user joined the organization. The roles of
+	// the user are then managed with an OrganizationUser.
+	Accepted bool `json:"accepted,omitempty"`
+}
+
+// OrganizationInvitationSpec defines the desired state of an
+// OrganizationInvitation.
+type OrganizationInvitationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationInvitationParameters `json:"forProvider"`
+}
+
+// OrganizationInvitationStatus represents the observed state of an
+// OrganizationInvitation.
+type OrganizationInvitationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationInvitationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationInvitation invites a user to a MongoDB Atlas organization.
+// Expired invitations are sent again.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.username"
+// +kubebuilder:printcolumn:name="ACCEPTED",type="boolean",JSONPath=".status.atProvider.accepted"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type OrganizationInvitation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationInvitationSpec   `json:"spec"`
+	Status OrganizationInvitationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationInvitationList contains a list of OrganizationInvitation
+type OrganizationInvitationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationInvitation `json:"items"`
+}
+
+// OrganizationInvitation type metadata.
+var (
+	OrganizationInvitationKind             = reflect.TypeOf(OrganizationInvitation{}).Name()
+	OrganizationInvitationGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationInvitationKind}.String()
+	OrganizationInvitationKindAPIVersion   = OrganizationInvitationKind + "." + SchemeGroupVersion.String()
+	OrganizationInvitationGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationInvitationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationInvitation{}, &OrganizationInvitationList{})
+}
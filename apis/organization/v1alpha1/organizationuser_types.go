@@ -0,0 +1,98 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OrganizationUserParameters are the configurable fields of an
+// OrganizationUser.
+type OrganizationUserParameters struct {
+	// OrganizationRef references the Organization the user is a member of.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// Username of a member of the organization. Users join an organization
+	// by accepting an OrganizationInvitation.
+	Username string `json:"username"`
+
+	// Roles of the user in the organization. Roles changed outside of
+	// Crossplane are reverted.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Roles []string `json:"roles"`
+
+	// RemoveOnDelete removes the user from the organization when the
+	// OrganizationUser is deleted. By default only the roles of the spec are
+	// revoked, and a user left without roles keeps ORG_MEMBER.
+	// +optional
+	RemoveOnDelete bool `json:"removeOnDelete,omitempty"`
+}
+
+// OrganizationUserObservation are the observable fields of an
+// OrganizationUser.
+type OrganizationUserObservation struct {
+	ID    string   `json:"id,omitempty"`
+	OrgID string   `json:"orgID,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+	// TeamIDs are the teams of the organization the user is a member of.
+	TeamIDs []string `json:"teamIDs,omitempty"`
+}
+
+// OrganizationUserSpec defines the desired state of an OrganizationUser.
+type OrganizationUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationUserParameters `json:"forProvider"`
+}
+
+// OrganizationUserStatus represents the observed state of an
+// OrganizationUser.
+type OrganizationUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationUser manages the roles of a member of a MongoDB Atlas
+// organization. Deleting it revokes the roles of the spec from the user, or
+// removes the user from the organization if removeOnDelete is true, unless
+// the deletionPolicy is Orphan.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.username"
+// +kubebuilder:printcolumn:name="ORGANIZATION",type="string",JSONPath=".spec.forProvider.organizationRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type OrganizationUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationUserSpec   `json:"spec"`
+	Status OrganizationUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationUserList contains a list of OrganizationUser
+type OrganizationUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationUser `json:"items"`
+}
+
+// OrganizationUser type metadata.
+var (
+	OrganizationUserKind             = reflect.TypeOf(OrganizationUser{}).Name()
+	OrganizationUserGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationUserKind}.String()
+	OrganizationUserKindAPIVersion   = OrganizationUserKind + "." + SchemeGroupVersion.String()
+	OrganizationUserGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationUser{}, &OrganizationUserList{})
+}
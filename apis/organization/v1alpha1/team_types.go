@@ -0,0 +1,87 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TeamParameters are the configurable fields of a Team.
+type TeamParameters struct {
+	// OrganizationRef references the Organization the team belongs to. The
+	// provider manages the team with the API key of the organization.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// Name of the team. Defaults to the name of the resource.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Usernames of the members of the team. Users must be members of the
+	// organization. Members added or removed outside of Crossplane are
+	// reverted.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Usernames []string `json:"usernames"`
+}
+
+// TeamObservation are the observable fields of a Team.
+type TeamObservation struct {
+	ID    string `json:"id,omitempty"`
+	OrgID string `json:"orgID,omitempty"`
+	// Usernames of the members of the team in Atlas.
+	Usernames []string `json:"usernames,omitempty"`
+}
+
+// TeamSpec defines the desired state of a Team.
+type TeamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamParameters `json:"forProvider"`
+}
+
+// TeamStatus represents the observed state of a Team.
+type TeamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Team is a team of users of a MongoDB Atlas organization.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TEAM-ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="ORGANIZATION",type="string",JSONPath=".spec.forProvider.organizationRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type Team struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamSpec   `json:"spec"`
+	Status TeamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamList contains a list of Team
+type TeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Team `json:"items"`
+}
+
+// Team type metadata.
+var (
+	TeamKind             = reflect.TypeOf(Team{}).Name()
+	TeamGroupKind        = schema.GroupKind{Group: Group, Kind: TeamKind}.String()
+	TeamKindAPIVersion   = TeamKind + "." + SchemeGroupVersion.String()
+	TeamGroupVersionKind = SchemeGroupVersion.WithKind(TeamKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Team{}, &TeamList{})
+}
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -37,6 +38,30 @@ func (in *AWSSecretsManagerReference) DeepCopyInto(out *AWSSecretsManagerReferen
 		*out = new(string)
 		**out = **in
 	}
+	if in.RecoveryWindowInDays != nil {
+		in, out := &in.RecoveryWindowInDays, &out.RecoveryWindowInDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourcePolicy != nil {
+		in, out := &in.ResourcePolicy, &out.ResourcePolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicaRegions != nil {
+		in, out := &in.ReplicaRegions, &out.ReplicaRegions
+		*out = make([]SecretReplicaRegion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecretsManagerReference.
@@ -84,6 +109,11 @@ func (in *OrganizationAPIKey) DeepCopyInto(out *OrganizationAPIKey) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AccessList != nil {
+		in, out := &in.AccessList, &out.AccessList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationAPIKey.
@@ -96,6 +126,146 @@ func (in *OrganizationAPIKey) DeepCopy() *OrganizationAPIKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInvitation) DeepCopyInto(out *OrganizationInvitation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInvitation.
+func (in *OrganizationInvitation) DeepCopy() *OrganizationInvitation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInvitation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationInvitation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInvitationList) DeepCopyInto(out *OrganizationInvitationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationInvitation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInvitationList.
+func (in *OrganizationInvitationList) DeepCopy() *OrganizationInvitationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInvitationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationInvitationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInvitationObservation) DeepCopyInto(out *OrganizationInvitationObservation) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInvitationObservation.
+func (in *OrganizationInvitationObservation) DeepCopy() *OrganizationInvitationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInvitationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInvitationParameters) DeepCopyInto(out *OrganizationInvitationParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TeamRefs != nil {
+		in, out := &in.TeamRefs, &out.TeamRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInvitationParameters.
+func (in *OrganizationInvitationParameters) DeepCopy() *OrganizationInvitationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInvitationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInvitationSpec) DeepCopyInto(out *OrganizationInvitationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInvitationSpec.
+func (in *OrganizationInvitationSpec) DeepCopy() *OrganizationInvitationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInvitationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInvitationStatus) DeepCopyInto(out *OrganizationInvitationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInvitationStatus.
+func (in *OrganizationInvitationStatus) DeepCopy() *OrganizationInvitationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInvitationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
 	*out = *in
@@ -135,6 +305,30 @@ func (in *OrganizationObservation) DeepCopyInto(out *OrganizationObservation) {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicationStatus != nil {
+		in, out := &in.ReplicationStatus, &out.ReplicationStatus
+		*out = make([]SecretReplicationStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeletedAt != nil {
+		in, out := &in.DeletedAt, &out.DeletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.APIKeyAccessList != nil {
+		in, out := &in.APIKeyAccessList, &out.APIKeyAccessList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(OrganizationSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationObservation.
@@ -150,8 +344,27 @@ func (in *OrganizationObservation) DeepCopy() *OrganizationObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrganizationParameters) DeepCopyInto(out *OrganizationParameters) {
 	*out = *in
-	in.APIKey.DeepCopyInto(&out.APIKey)
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(OrganizationAPIKey)
+		(*in).DeepCopyInto(*out)
+	}
 	in.AWSSecretsConfig.DeepCopyInto(&out.AWSSecretsConfig)
+	if in.VaultConfig != nil {
+		in, out := &in.VaultConfig, &out.VaultConfig
+		*out = new(VaultReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretPayload != nil {
+		in, out := &in.SecretPayload, &out.SecretPayload
+		*out = new(SecretPayload)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(OrganizationSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationParameters.
@@ -164,6 +377,46 @@ func (in *OrganizationParameters) DeepCopy() *OrganizationParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSettings) DeepCopyInto(out *OrganizationSettings) {
+	*out = *in
+	if in.APIAccessListRequired != nil {
+		in, out := &in.APIAccessListRequired, &out.APIAccessListRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MultiFactorAuthRequired != nil {
+		in, out := &in.MultiFactorAuthRequired, &out.MultiFactorAuthRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestrictEmployeeAccess != nil {
+		in, out := &in.RestrictEmployeeAccess, &out.RestrictEmployeeAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GenAIFeaturesEnabled != nil {
+		in, out := &in.GenAIFeaturesEnabled, &out.GenAIFeaturesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityContact != nil {
+		in, out := &in.SecurityContact, &out.SecurityContact
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSettings.
+func (in *OrganizationSettings) DeepCopy() *OrganizationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
 	*out = *in
@@ -197,3 +450,359 @@ func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationUser) DeepCopyInto(out *OrganizationUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUser.
+func (in *OrganizationUser) DeepCopy() *OrganizationUser {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationUserList) DeepCopyInto(out *OrganizationUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUserList.
+func (in *OrganizationUserList) DeepCopy() *OrganizationUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationUserObservation) DeepCopyInto(out *OrganizationUserObservation) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TeamIDs != nil {
+		in, out := &in.TeamIDs, &out.TeamIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUserObservation.
+func (in *OrganizationUserObservation) DeepCopy() *OrganizationUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationUserParameters) DeepCopyInto(out *OrganizationUserParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUserParameters.
+func (in *OrganizationUserParameters) DeepCopy() *OrganizationUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationUserSpec) DeepCopyInto(out *OrganizationUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUserSpec.
+func (in *OrganizationUserSpec) DeepCopy() *OrganizationUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationUserStatus) DeepCopyInto(out *OrganizationUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationUserStatus.
+func (in *OrganizationUserStatus) DeepCopy() *OrganizationUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretPayload) DeepCopyInto(out *SecretPayload) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyNames != nil {
+		in, out := &in.KeyNames, &out.KeyNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPayload.
+func (in *SecretPayload) DeepCopy() *SecretPayload {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretPayload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReplicaRegion) DeepCopyInto(out *SecretReplicaRegion) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReplicaRegion.
+func (in *SecretReplicaRegion) DeepCopy() *SecretReplicaRegion {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReplicaRegion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReplicationStatus) DeepCopyInto(out *SecretReplicationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReplicationStatus.
+func (in *SecretReplicationStatus) DeepCopy() *SecretReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Team) DeepCopyInto(out *Team) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
+func (in *Team) DeepCopy() *Team {
+	if in == nil {
+		return nil
+	}
+	out := new(Team)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Team) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamList) DeepCopyInto(out *TeamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Team, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
+func (in *TeamList) DeepCopy() *TeamList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamObservation) DeepCopyInto(out *TeamObservation) {
+	*out = *in
+	if in.Usernames != nil {
+		in, out := &in.Usernames, &out.Usernames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamObservation.
+func (in *TeamObservation) DeepCopy() *TeamObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamParameters) DeepCopyInto(out *TeamParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+	if in.Usernames != nil {
+		in, out := &in.Usernames, &out.Usernames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamParameters.
+func (in *TeamParameters) DeepCopy() *TeamParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
+func (in *TeamSpec) DeepCopy() *TeamSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
+func (in *TeamStatus) DeepCopy() *TeamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultReference) DeepCopyInto(out *VaultReference) {
+	*out = *in
+	in.StoreConfigRef.DeepCopyInto(&out.StoreConfigRef)
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultReference.
+func (in *VaultReference) DeepCopy() *VaultReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultReference)
+	in.DeepCopyInto(out)
+	return out
+}
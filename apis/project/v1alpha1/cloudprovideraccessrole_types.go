@@ -0,0 +1,110 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CloudProviderAccessRoleParameters are the configurable fields of a
+// CloudProviderAccessRole.
+type CloudProviderAccessRoleParameters struct {
+	// OrganizationRef references the Organization that owns the project. The
+	// provider manages the project with the API key of the organization.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// ProjectID is the ID of the Atlas project.
+	ProjectID string `json:"projectID"`
+
+	// IAMAssumedRoleARN is the ARN of the AWS IAM role that Atlas assumes.
+	// The role is authorized once this is set. The trust policy of the IAM
+	// role must allow the Atlas AWS account to assume it with the external ID
+	// reported in the status.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	IAMAssumedRoleARN string `json:"iamAssumedRoleARN,omitempty"`
+}
+
+// CloudProviderAccessRoleObservation are the observable fields of a
+// CloudProviderAccessRole.
+type CloudProviderAccessRoleObservation struct {
+	// RoleID is the ID of the role in Atlas.
+	RoleID string `json:"roleID,omitempty"`
+
+	// AtlasAWSAccountARN is the ARN of the AWS account Atlas assumes the IAM
+	// role from.
+	AtlasAWSAccountARN string `json:"atlasAWSAccountARN,omitempty"`
+
+	// AtlasAssumedRoleExternalID is the external ID Atlas uses to assume the
+	// IAM role.
+	AtlasAssumedRoleExternalID string `json:"atlasAssumedRoleExternalID,omitempty"`
+
+	// IAMAssumedRoleARN is the ARN of the authorized IAM role.
+	IAMAssumedRoleARN string `json:"iamAssumedRoleARN,omitempty"`
+
+	// Authorized is true if Atlas may assume the IAM role.
+	Authorized bool `json:"authorized,omitempty"`
+
+	// AuthorizedDate is when the IAM role was authorized.
+	AuthorizedDate *metav1.Time `json:"authorizedDate,omitempty"`
+
+	// CreatedDate is when the role was created.
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+}
+
+// CloudProviderAccessRoleSpec defines the desired state of a
+// CloudProviderAccessRole.
+type CloudProviderAccessRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudProviderAccessRoleParameters `json:"forProvider"`
+}
+
+// CloudProviderAccessRoleStatus represents the observed state of a
+// CloudProviderAccessRole.
+type CloudProviderAccessRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudProviderAccessRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudProviderAccessRole lets MongoDB Atlas assume an AWS IAM role of a
+// project, e.g. to use KMS keys for encryption at rest.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLE-ID",type="string",JSONPath=".status.atProvider.roleID"
+// +kubebuilder:printcolumn:name="AUTHORIZED",type="boolean",JSONPath=".status.atProvider.authorized"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type CloudProviderAccessRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudProviderAccessRoleSpec   `json:"spec"`
+	Status CloudProviderAccessRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudProviderAccessRoleList contains a list of CloudProviderAccessRole
+type CloudProviderAccessRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudProviderAccessRole `json:"items"`
+}
+
+// CloudProviderAccessRole type metadata.
+var (
+	CloudProviderAccessRoleKind             = reflect.TypeOf(CloudProviderAccessRole{}).Name()
+	CloudProviderAccessRoleGroupKind        = schema.GroupKind{Group: Group, Kind: CloudProviderAccessRoleKind}.String()
+	CloudProviderAccessRoleKindAPIVersion   = CloudProviderAccessRoleKind + "." + SchemeGroupVersion.String()
+	CloudProviderAccessRoleGroupVersionKind = SchemeGroupVersion.WithKind(CloudProviderAccessRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudProviderAccessRole{}, &CloudProviderAccessRoleList{})
+}
@@ -0,0 +1,113 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ProjectEncryptionAtRestParameters are the configurable fields of a
+// ProjectEncryptionAtRest.
+type ProjectEncryptionAtRestParameters struct {
+	// OrganizationRef references the Organization that owns the project. The
+	// provider manages the project with the API key of the organization.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// ProjectID is the ID of the Atlas project.
+	ProjectID string `json:"projectID"`
+
+	// RoleRef references the CloudProviderAccessRole that Atlas assumes to
+	// use the key. The role must be authorized. Exactly one of RoleRef or
+	// RoleID is required.
+	// +optional
+	RoleRef *xpv1.Reference `json:"roleRef,omitempty"`
+
+	// RoleID is the ID of the Atlas cloud provider access role that Atlas
+	// assumes to use the key.
+	// +optional
+	RoleID string `json:"roleID,omitempty"`
+
+	// KeyARN is the ARN of the customer managed AWS KMS key.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:kms:[a-z0-9-]+:[0-9]{12}:key/.+$`
+	KeyARN string `json:"keyARN"`
+
+	// Region of the key, e.g. eu-west-1. Defaults to the region of KeyARN.
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+// ProjectEncryptionAtRestObservation are the observable fields of a
+// ProjectEncryptionAtRest.
+type ProjectEncryptionAtRestObservation struct {
+	// Enabled is true if encryption at rest with the key is enabled.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Valid is true if Atlas can use the key.
+	Valid bool `json:"valid,omitempty"`
+
+	// CustomerMasterKeyID is the ID of the key Atlas uses.
+	CustomerMasterKeyID string `json:"customerMasterKeyID,omitempty"`
+
+	// Region is the Atlas region of the key, e.g. EU_WEST_1.
+	Region string `json:"region,omitempty"`
+
+	// RoleID is the ID of the role Atlas assumes to use the key.
+	RoleID string `json:"roleID,omitempty"`
+}
+
+// ProjectEncryptionAtRestSpec defines the desired state of a
+// ProjectEncryptionAtRest.
+type ProjectEncryptionAtRestSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectEncryptionAtRestParameters `json:"forProvider"`
+}
+
+// ProjectEncryptionAtRestStatus represents the observed state of a
+// ProjectEncryptionAtRest.
+type ProjectEncryptionAtRestStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectEncryptionAtRestObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectEncryptionAtRest encrypts the data of a MongoDB Atlas project with
+// a customer managed AWS KMS key.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT-ID",type="string",JSONPath=".spec.forProvider.projectID"
+// +kubebuilder:printcolumn:name="VALID",type="boolean",JSONPath=".status.atProvider.valid"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type ProjectEncryptionAtRest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectEncryptionAtRestSpec   `json:"spec"`
+	Status ProjectEncryptionAtRestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectEncryptionAtRestList contains a list of ProjectEncryptionAtRest
+type ProjectEncryptionAtRestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectEncryptionAtRest `json:"items"`
+}
+
+// ProjectEncryptionAtRest type metadata.
+var (
+	ProjectEncryptionAtRestKind             = reflect.TypeOf(ProjectEncryptionAtRest{}).Name()
+	ProjectEncryptionAtRestGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectEncryptionAtRestKind}.String()
+	ProjectEncryptionAtRestKindAPIVersion   = ProjectEncryptionAtRestKind + "." + SchemeGroupVersion.String()
+	ProjectEncryptionAtRestGroupVersionKind = SchemeGroupVersion.WithKind(ProjectEncryptionAtRestKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProjectEncryptionAtRest{}, &ProjectEncryptionAtRestList{})
+}
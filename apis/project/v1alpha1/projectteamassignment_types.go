@@ -0,0 +1,105 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ProjectTeam grants a Team roles in a project.
+type ProjectTeam struct {
+	// TeamRef references the Team.
+	TeamRef xpv1.Reference `json:"teamRef"`
+
+	// Roles of the team in the project, e.g. GROUP_READ_ONLY.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Roles []string `json:"roles"`
+}
+
+// ProjectTeamAssignmentParameters are the configurable fields of a
+// ProjectTeamAssignment.
+type ProjectTeamAssignmentParameters struct {
+	// OrganizationRef references the Organization that owns the project. The
+	// provider manages the project with the API key of the organization.
+	OrganizationRef xpv1.Reference `json:"organizationRef"`
+
+	// ProjectID is the ID of the Atlas project.
+	ProjectID string `json:"projectID"`
+
+	// Teams assigned to the project. Teams removed from the list are removed
+	// from the project; teams assigned outside of Crossplane are left alone.
+	// +kubebuilder:validation:MinItems=1
+	Teams []ProjectTeam `json:"teams"`
+}
+
+// ProjectTeamObservation is a team assigned to a project in Atlas.
+type ProjectTeamObservation struct {
+	TeamID string   `json:"teamID"`
+	Roles  []string `json:"roles,omitempty"`
+}
+
+// ProjectTeamAssignmentObservation are the observable fields of a
+// ProjectTeamAssignment.
+type ProjectTeamAssignmentObservation struct {
+	Teams []ProjectTeamObservation `json:"teams,omitempty"`
+
+	// AppliedTeamIDs are the IDs of the teams this resource assigned to the
+	// project. Only these teams are removed from the project.
+	AppliedTeamIDs []string `json:"appliedTeamIDs,omitempty"`
+}
+
+// ProjectTeamAssignmentSpec defines the desired state of a
+// ProjectTeamAssignment.
+type ProjectTeamAssignmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectTeamAssignmentParameters `json:"forProvider"`
+}
+
+// ProjectTeamAssignmentStatus represents the observed state of a
+// ProjectTeamAssignment.
+type ProjectTeamAssignmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectTeamAssignmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectTeamAssignment grants Teams roles in a MongoDB Atlas project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT-ID",type="string",JSONPath=".spec.forProvider.projectID"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,mongodb}
+type ProjectTeamAssignment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectTeamAssignmentSpec   `json:"spec"`
+	Status ProjectTeamAssignmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectTeamAssignmentList contains a list of ProjectTeamAssignment
+type ProjectTeamAssignmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectTeamAssignment `json:"items"`
+}
+
+// ProjectTeamAssignment type metadata.
+var (
+	ProjectTeamAssignmentKind             = reflect.TypeOf(ProjectTeamAssignment{}).Name()
+	ProjectTeamAssignmentGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectTeamAssignmentKind}.String()
+	ProjectTeamAssignmentKindAPIVersion   = ProjectTeamAssignmentKind + "." + SchemeGroupVersion.String()
+	ProjectTeamAssignmentGroupVersionKind = SchemeGroupVersion.WithKind(ProjectTeamAssignmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProjectTeamAssignment{}, &ProjectTeamAssignmentList{})
+}
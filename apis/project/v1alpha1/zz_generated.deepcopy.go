@@ -0,0 +1,471 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProviderAccessRole) DeepCopyInto(out *CloudProviderAccessRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderAccessRole.
+func (in *CloudProviderAccessRole) DeepCopy() *CloudProviderAccessRole {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProviderAccessRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudProviderAccessRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProviderAccessRoleList) DeepCopyInto(out *CloudProviderAccessRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudProviderAccessRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderAccessRoleList.
+func (in *CloudProviderAccessRoleList) DeepCopy() *CloudProviderAccessRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProviderAccessRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudProviderAccessRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProviderAccessRoleObservation) DeepCopyInto(out *CloudProviderAccessRoleObservation) {
+	*out = *in
+	if in.AuthorizedDate != nil {
+		in, out := &in.AuthorizedDate, &out.AuthorizedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderAccessRoleObservation.
+func (in *CloudProviderAccessRoleObservation) DeepCopy() *CloudProviderAccessRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProviderAccessRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProviderAccessRoleParameters) DeepCopyInto(out *CloudProviderAccessRoleParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderAccessRoleParameters.
+func (in *CloudProviderAccessRoleParameters) DeepCopy() *CloudProviderAccessRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProviderAccessRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProviderAccessRoleSpec) DeepCopyInto(out *CloudProviderAccessRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderAccessRoleSpec.
+func (in *CloudProviderAccessRoleSpec) DeepCopy() *CloudProviderAccessRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProviderAccessRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProviderAccessRoleStatus) DeepCopyInto(out *CloudProviderAccessRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderAccessRoleStatus.
+func (in *CloudProviderAccessRoleStatus) DeepCopy() *CloudProviderAccessRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProviderAccessRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectEncryptionAtRest) DeepCopyInto(out *ProjectEncryptionAtRest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectEncryptionAtRest.
+func (in *ProjectEncryptionAtRest) DeepCopy() *ProjectEncryptionAtRest {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectEncryptionAtRest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectEncryptionAtRest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectEncryptionAtRestList) DeepCopyInto(out *ProjectEncryptionAtRestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectEncryptionAtRest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectEncryptionAtRestList.
+func (in *ProjectEncryptionAtRestList) DeepCopy() *ProjectEncryptionAtRestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectEncryptionAtRestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectEncryptionAtRestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectEncryptionAtRestObservation) DeepCopyInto(out *ProjectEncryptionAtRestObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectEncryptionAtRestObservation.
+func (in *ProjectEncryptionAtRestObservation) DeepCopy() *ProjectEncryptionAtRestObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectEncryptionAtRestObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectEncryptionAtRestParameters) DeepCopyInto(out *ProjectEncryptionAtRestParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectEncryptionAtRestParameters.
+func (in *ProjectEncryptionAtRestParameters) DeepCopy() *ProjectEncryptionAtRestParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectEncryptionAtRestParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectEncryptionAtRestSpec) DeepCopyInto(out *ProjectEncryptionAtRestSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectEncryptionAtRestSpec.
+func (in *ProjectEncryptionAtRestSpec) DeepCopy() *ProjectEncryptionAtRestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectEncryptionAtRestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectEncryptionAtRestStatus) DeepCopyInto(out *ProjectEncryptionAtRestStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectEncryptionAtRestStatus.
+func (in *ProjectEncryptionAtRestStatus) DeepCopy() *ProjectEncryptionAtRestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectEncryptionAtRestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTeam) DeepCopyInto(out *ProjectTeam) {
+	*out = *in
+	in.TeamRef.DeepCopyInto(&out.TeamRef)
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeam.
+func (in *ProjectTeam) DeepCopy() *ProjectTeam {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTeam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTeamAssignment) DeepCopyInto(out *ProjectTeamAssignment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAssignment.
+func (in *ProjectTeamAssignment) DeepCopy() *ProjectTeamAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTeamAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectTeamAssignment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTeamAssignmentList) DeepCopyInto(out *ProjectTeamAssignmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectTeamAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAssignmentList.
+func (in *ProjectTeamAssignmentList) DeepCopy() *ProjectTeamAssignmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTeamAssignmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectTeamAssignmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTeamAssignmentObservation) DeepCopyInto(out *ProjectTeamAssignmentObservation) {
+	*out = *in
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]ProjectTeamObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliedTeamIDs != nil {
+		in, out := &in.AppliedTeamIDs, &out.AppliedTeamIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAssignmentObservation.
+func (in *ProjectTeamAssignmentObservation) DeepCopy() *ProjectTeamAssignmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTeamAssignmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTeamAssignmentParameters) DeepCopyInto(out *ProjectTeamAssignmentParameters) {
+	*out = *in
+	in.OrganizationRef.DeepCopyInto(&out.OrganizationRef)
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]ProjectTeam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAssignmentParameters.
+func (in *ProjectTeamAssignmentParameters) DeepCopy() *ProjectTeamAssignmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTeamAssignmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTeamAssignmentSpec) DeepCopyInto(out *ProjectTeamAssignmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAssignmentSpec.
+func (in *ProjectTeamAssignmentSpec) DeepCopy() *ProjectTeamAssignmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTeamAssignmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTeamAssignmentStatus) DeepCopyInto(out *ProjectTeamAssignmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAssignmentStatus.
+func (in *ProjectTeamAssignmentStatus) DeepCopy() *ProjectTeamAssignmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTeamAssignmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTeamObservation) DeepCopyInto(out *ProjectTeamObservation) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamObservation.
+func (in *ProjectTeamObservation) DeepCopy() *ProjectTeamObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTeamObservation)
+	in.DeepCopyInto(out)
+	return out
+}
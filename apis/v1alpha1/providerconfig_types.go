@@ -20,7 +20,8 @@ import (
 )
 
 const (
-	CredentialsSourceAWS xpv1.CredentialsSource = "AWS"
+	CredentialsSourceAWS   xpv1.CredentialsSource = "AWS"
+	CredentialsSourceVault xpv1.CredentialsSource = "Vault"
 )
 
 // AWSSecretsManagerReference holds configuration for AWS Secrets Manager storage.
@@ -36,15 +37,61 @@ type AWSCredentialsSource struct {
 	SecretsManager *AWSSecretsManagerReference `json:"secretsManager,omitempty"`
 }
 
+// VaultCredentialsSource reads the credentials from a Vault KV v2 secrets
+// engine.
+type VaultCredentialsSource struct {
+	// StoreConfigRef references the StoreConfig configuring the Vault server
+	// and how to log in to it.
+	StoreConfigRef xpv1.Reference `json:"storeConfigRef"`
+
+	// Path of the secret holding the publicKey and privateKey.
+	Path string `json:"path"`
+}
+
 // ProviderCredentials holds credentials source details.
 type ProviderCredentials struct {
+	// Source of the credentials. Secret sources hold the publicKey and
+	// privateKey as JSON. Organizations writing their API key to AWS Secrets
+	// Manager need the aws configuration with any source.
+	// +kubebuilder:validation:Enum=AWS;Vault;Secret
 	Source xpv1.CredentialsSource `json:"source"`
 	AWS    *AWSCredentialsSource  `json:"aws,omitempty"`
+
+	// Vault configures the Vault source.
+	// +optional
+	Vault *VaultCredentialsSource `json:"vault,omitempty"`
+
+	xpv1.CommonCredentialSelectors `json:",inline"`
+}
+
+// SecretNaming configures the names of the secrets written for managed
+// resources using a ProviderConfig.
+type SecretNaming struct {
+	// Prefix is prepended to the secret name of a resource.
+	// +kubebuilder:default="product/mongodb/"
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Template renders the full secret name as a Go template and takes
+	// precedence over Prefix, e.g. "{{.Env}}/mongodb/{{.Name}}". The
+	// template can use .Name (the secret name of the resource), .Env and
+	// .ProviderConfig.
+	// +optional
+	Template *string `json:"template,omitempty"`
+
+	// Env is available to the template as .Env.
+	// +optional
+	Env string `json:"env,omitempty"`
 }
 
 // ProviderConfigSpec defines the desired state of ProviderConfig.
 type ProviderConfigSpec struct {
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// SecretNaming configures the names of the secrets written for managed
+	// resources. Secrets are moved when their name changes.
+	// +optional
+	SecretNaming *SecretNaming `json:"secretNaming,omitempty"`
 }
 
 // ProviderConfigStatus represents the observed state of ProviderConfig.
@@ -28,6 +28,64 @@ import (
 // A StoreConfigSpec defines the desired state of a ProviderConfig.
 type StoreConfigSpec struct {
 	xpv1.SecretStoreConfig `json:",inline"`
+
+	// VaultAuth configures how the provider authenticates to Vault when it
+	// writes credentials of managed resources, e.g. Organization API keys,
+	// instead of the token auth of the vault configuration.
+	// +optional
+	VaultAuth *VaultAuth `json:"vaultAuth,omitempty"`
+}
+
+// Vault auth methods supported in addition to token auth.
+const (
+	VaultAuthMethodAppRole    = "AppRole"
+	VaultAuthMethodKubernetes = "Kubernetes"
+)
+
+// VaultAuth configures an additional Vault auth method.
+type VaultAuth struct {
+	// Method used to log in to Vault.
+	// +kubebuilder:validation:Enum=AppRole;Kubernetes
+	Method string `json:"method"`
+
+	// AppRole configures the AppRole auth method.
+	// +optional
+	AppRole *VaultAppRoleAuth `json:"appRole,omitempty"`
+
+	// Kubernetes configures the Kubernetes auth method.
+	// +optional
+	Kubernetes *VaultKubernetesAuth `json:"kubernetes,omitempty"`
+}
+
+// VaultAppRoleAuth configures the AppRole auth method.
+type VaultAppRoleAuth struct {
+	// MountPath of the auth method.
+	// +kubebuilder:default=approle
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// RoleID of the AppRole.
+	RoleID string `json:"roleId"`
+
+	// SecretIDSecretRef references the secret ID of the AppRole.
+	SecretIDSecretRef xpv1.SecretKeySelector `json:"secretIdSecretRef"`
+}
+
+// VaultKubernetesAuth configures the Kubernetes auth method with the service
+// account token of the provider.
+type VaultKubernetesAuth struct {
+	// MountPath of the auth method.
+	// +kubebuilder:default=kubernetes
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// Role to log in with.
+	Role string `json:"role"`
+
+	// TokenPath is the path of the service account token.
+	// +kubebuilder:default="/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// +optional
+	TokenPath string `json:"tokenPath,omitempty"`
 }
 
 // A StoreConfigStatus represents the status of a StoreConfig.
@@ -138,6 +138,11 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 	*out = *in
 	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.SecretNaming != nil {
+		in, out := &in.SecretNaming, &out.SecretNaming
+		*out = new(SecretNaming)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
@@ -237,6 +242,12 @@ func (in *ProviderCredentials) DeepCopyInto(out *ProviderCredentials) {
 		*out = new(AWSCredentialsSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultCredentialsSource)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCredentials.
@@ -249,6 +260,31 @@ func (in *ProviderCredentials) DeepCopy() *ProviderCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretNaming) DeepCopyInto(out *SecretNaming) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretNaming.
+func (in *SecretNaming) DeepCopy() *SecretNaming {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretNaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StoreConfig) DeepCopyInto(out *StoreConfig) {
 	*out = *in
@@ -312,6 +348,11 @@ func (in *StoreConfigList) DeepCopyObject() runtime.Object {
 func (in *StoreConfigSpec) DeepCopyInto(out *StoreConfigSpec) {
 	*out = *in
 	in.SecretStoreConfig.DeepCopyInto(&out.SecretStoreConfig)
+	if in.VaultAuth != nil {
+		in, out := &in.VaultAuth, &out.VaultAuth
+		*out = new(VaultAuth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreConfigSpec.
@@ -339,3 +380,75 @@ func (in *StoreConfigStatus) DeepCopy() *StoreConfigStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultAppRoleAuth) DeepCopyInto(out *VaultAppRoleAuth) {
+	*out = *in
+	out.SecretIDSecretRef = in.SecretIDSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultAppRoleAuth.
+func (in *VaultAppRoleAuth) DeepCopy() *VaultAppRoleAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultAppRoleAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultAuth) DeepCopyInto(out *VaultAuth) {
+	*out = *in
+	if in.AppRole != nil {
+		in, out := &in.AppRole, &out.AppRole
+		*out = new(VaultAppRoleAuth)
+		**out = **in
+	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(VaultKubernetesAuth)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultAuth.
+func (in *VaultAuth) DeepCopy() *VaultAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultCredentialsSource) DeepCopyInto(out *VaultCredentialsSource) {
+	*out = *in
+	in.StoreConfigRef.DeepCopyInto(&out.StoreConfigRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultCredentialsSource.
+func (in *VaultCredentialsSource) DeepCopy() *VaultCredentialsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultCredentialsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultKubernetesAuth) DeepCopyInto(out *VaultKubernetesAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultKubernetesAuth.
+func (in *VaultKubernetesAuth) DeepCopy() *VaultKubernetesAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultKubernetesAuth)
+	in.DeepCopyInto(out)
+	return out
+}
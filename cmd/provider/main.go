@@ -30,6 +30,7 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -42,6 +43,8 @@ import (
 	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
 	gateway "github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller"
 	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/features"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+	validation "github.com/svchaudhari/Swap-Provider-MongoDB/internal/webhook"
 )
 
 // UseISO8601 sets the logger to uses ISO8601 timestamp format
@@ -63,6 +66,13 @@ func main() {
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
+		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+
+		tracingEndpoint    = app.Flag("tracing-endpoint", "OTLP gRPC endpoint (host:port) to export traces to. Tracing is disabled when empty.").Default("").String()
+		tracingInsecure    = app.Flag("tracing-insecure", "Export traces without TLS.").Default("false").Bool()
+		tracingSampleRatio = app.Flag("tracing-sample-ratio", "Fraction of reconciles that are traced.").Default("1.0").Float64()
+
+		webhookTLSCertDir = app.Flag("webhook-tls-cert-dir", "The directory of TLS certificate that will be used by the webhook server. There should be tls.crt and tls.key files. Webhooks are disabled when empty.").Envar("WEBHOOK_TLS_CERT_DIR").String()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -75,6 +85,18 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
+	shutdownTracing := func(context.Context) error { return nil }
+	if *tracingEndpoint != "" {
+		shutdown, err := tracing.Setup(context.Background(), tracing.Options{
+			Endpoint:    *tracingEndpoint,
+			Insecure:    *tracingInsecure,
+			SampleRatio: *tracingSampleRatio,
+		})
+		kingpin.FatalIfError(err, "Cannot set up tracing")
+		shutdownTracing = shutdown
+		log.Info("Tracing enabled", "endpoint", *tracingEndpoint)
+	}
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
@@ -93,6 +115,10 @@ func main() {
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+
+		WebhookServer: webhook.NewServer(webhook.Options{
+			CertDir: *webhookTLSCertDir,
+		}),
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add App Gateway APIs to scheme")
@@ -124,6 +150,17 @@ func main() {
 		})), "cannot create default store config")
 	}
 
+	if *enableManagementPolicies {
+		o.Features.Enable(feature.EnableAlphaManagementPolicies)
+		log.Info("Alpha feature enabled", "flag", feature.EnableAlphaManagementPolicies)
+	}
+
 	kingpin.FatalIfError(gateway.Setup(mgr, o), "Cannot setup App Gateway controllers")
-	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
+	if *webhookTLSCertDir != "" {
+		kingpin.FatalIfError(validation.Setup(mgr), "Cannot setup webhooks")
+	}
+	err = mgr.Start(ctrl.SetupSignalHandler())
+	// kingpin exits without running deferred functions, flush traces first.
+	shutdownTracing(context.Background()) //nolint:errcheck // best effort flush on exit
+	kingpin.FatalIfError(err, "Cannot start controller manager")
 }
@@ -4,15 +4,55 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/metrics"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
 )
 
+// SecretStore stores MongoDB API credentials in AWS Secrets Manager.
+type SecretStore interface {
+	PutSecret(ctx context.Context, secretName string, creds MongoDBAPICredentials, format PayloadFormat, opts SecretOptions) (string, error)
+	CopySecret(ctx context.Context, from, to, orgID string, opts SecretOptions) (string, error)
+	GetSecret(ctx context.Context, secretName string) (*MongoDBAPICredentials, error)
+	GetSecretWithFormat(ctx context.Context, secretName string, format PayloadFormat) (*MongoDBAPICredentials, error)
+	DescribeSecret(ctx context.Context, secretName string) (*secretsmanager.DescribeSecretOutput, error)
+	DeleteSecret(ctx context.Context, secretName string, forceDelete bool, recoveryWindowInDays int64) error
+	RestoreSecret(ctx context.Context, secretName string) error
+	TagSecret(ctx context.Context, secretName string, tags map[string]string) error
+	UntagSecret(ctx context.Context, secretName string, keys []string) error
+	GetResourcePolicy(ctx context.Context, secretName string) (*string, error)
+	PutResourcePolicy(ctx context.Context, secretName, policy string) error
+	DeleteResourcePolicy(ctx context.Context, secretName string) error
+	ReplicateSecret(ctx context.Context, secretName string, regions []ReplicaRegion) error
+	RemoveReplicaRegions(ctx context.Context, secretName string, regions []string) error
+	ValidateKey(ctx context.Context, keyID string) (string, error)
+}
+
+var _ SecretStore = &Client{}
+
+// KeyStore checks KMS keys that MongoDB Atlas uses on behalf of a project.
+type KeyStore interface {
+	CheckKeyPolicy(ctx context.Context, keyID, principalARN string, actions []string) error
+}
+
+var _ KeyStore = &Client{}
+
 // Client wraps AWS services for KMS and Secrets Manager operations.
 type Client struct {
 	KMSClient            *kms.Client
@@ -21,12 +61,109 @@ type Client struct {
 }
 
 // MongoDBAPICredentials represents the structure of MongoDB API credentials.
+// Secrets written by older versions only contain the key pair.
 type MongoDBAPICredentials struct {
 	PublicKey  string   `json:"publicKey"`
 	PrivateKey string   `json:"privateKey"`
-//	OrgID      string   `json:"orgId"`
-//	Roles      []string `json:"roles"`
-//	CreatedAt  string   `json:"createdAt,omitempty"`
+	OrgID      string   `json:"orgId,omitempty"`
+	APIKeyID   string   `json:"apiKeyId,omitempty"`
+	Roles      []string `json:"roles,omitempty"`
+	BaseURL    string   `json:"baseUrl,omitempty"`
+	CreatedAt  string   `json:"createdAt,omitempty"`
+}
+
+// Fields of a MongoDB API credentials secret.
+const (
+	FieldPublicKey  = "publicKey"
+	FieldPrivateKey = "privateKey"
+	FieldOrgID      = "orgId"
+	FieldAPIKeyID   = "apiKeyId"
+	FieldRoles      = "roles"
+	FieldBaseURL    = "baseUrl"
+	FieldCreatedAt  = "createdAt"
+)
+
+// OptionalFields are the fields that may be stored next to the key pair.
+var OptionalFields = []string{FieldOrgID, FieldAPIKeyID, FieldRoles, FieldBaseURL, FieldCreatedAt}
+
+// PayloadFormat controls which fields of MongoDBAPICredentials are written to
+// a secret and under which keys. The zero value writes all fields under their
+// default keys.
+type PayloadFormat struct {
+	// Fields are the optional fields to write. The key pair is always
+	// written. All optional fields are written if empty.
+	Fields []string
+
+	// KeyNames maps field names to the keys used in the secret.
+	KeyNames map[string]string
+}
+
+func (f PayloadFormat) key(field string) string {
+	if k, ok := f.KeyNames[field]; ok && k != "" {
+		return k
+	}
+	return field
+}
+
+// Marshal encodes credentials according to the format.
+func (f PayloadFormat) Marshal(creds MongoDBAPICredentials) ([]byte, error) {
+	values := map[string]interface{}{
+		FieldOrgID:     creds.OrgID,
+		FieldAPIKeyID:  creds.APIKeyID,
+		FieldRoles:     creds.Roles,
+		FieldBaseURL:   creds.BaseURL,
+		FieldCreatedAt: creds.CreatedAt,
+	}
+	fields := f.Fields
+	if len(fields) == 0 {
+		fields = OptionalFields
+	}
+
+	payload := map[string]interface{}{
+		f.key(FieldPublicKey):  creds.PublicKey,
+		f.key(FieldPrivateKey): creds.PrivateKey,
+	}
+	for _, field := range fields {
+		v, ok := values[field]
+		if !ok {
+			return nil, errors.Errorf("unknown secret payload field %q", field)
+		}
+		payload[f.key(field)] = v
+	}
+	return json.Marshal(payload)
+}
+
+// Unmarshal decodes credentials according to the format. Fields missing under
+// their configured key are read from their default key, so secrets written
+// before the format changed remain readable.
+func (f PayloadFormat) Unmarshal(data []byte) (*MongoDBAPICredentials, error) {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	creds := &MongoDBAPICredentials{}
+	targets := map[string]interface{}{
+		FieldPublicKey:  &creds.PublicKey,
+		FieldPrivateKey: &creds.PrivateKey,
+		FieldOrgID:      &creds.OrgID,
+		FieldAPIKeyID:   &creds.APIKeyID,
+		FieldRoles:      &creds.Roles,
+		FieldBaseURL:    &creds.BaseURL,
+		FieldCreatedAt:  &creds.CreatedAt,
+	}
+	for field, target := range targets {
+		v, ok := raw[f.key(field)]
+		if !ok {
+			v, ok = raw[field]
+		}
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(v, target); err != nil {
+			return nil, errors.Wrapf(err, "cannot decode secret field %q", field)
+		}
+	}
+	return creds, nil
 }
 
 // NewClient creates a new AWS client with KMS and Secrets Manager services.
@@ -42,57 +179,201 @@ func NewClient(ctx context.Context, region string) (*Client, error) {
 	}, nil
 }
 
-// PutSecret creates or updates a MongoDB API key secret in AWS Secrets Manager.
-func (c *Client) PutSecret(ctx context.Context, secretName string, creds MongoDBAPICredentials, OrgID string, kmsKeyID *string) (string, error) {
-	data, err := json.Marshal(creds)
-	if err != nil {
-		return "", errors.Wrap(err, "cannot marshal MongoDB credentials")
+// NewSecretStore returns a SecretStore backed by AWS Secrets Manager in the
+// given region.
+func NewSecretStore(ctx context.Context, region string) (SecretStore, error) {
+	return NewClient(ctx, region)
+}
+
+// SecretOptions are the settings applied to a secret when it is written.
+type SecretOptions struct {
+	// KMSKeyID is the KMS key used to encrypt the secret.
+	KMSKeyID *string
+
+	// Tags are added to the DefaultTags of the secret.
+	Tags map[string]string
+
+	// ResourcePolicy is attached to the secret if set.
+	ResourcePolicy *string
+
+	// ReplicaRegions the secret is replicated to.
+	ReplicaRegions []ReplicaRegion
+}
+
+// ReplicaRegion is a region a secret is replicated to.
+type ReplicaRegion struct {
+	Region   string
+	KMSKeyID *string
+}
+
+// Tags of secrets retained after their organization was deleted.
+const (
+	TagOrphaned   = "Orphaned"
+	TagOrphanedAt = "OrphanedAt"
+)
+
+// DefaultTags returns the tags the provider sets on every secret of an
+// organization.
+func DefaultTags(orgID string) map[string]string {
+	return map[string]string{
+		"Provider":  "mongodb-crossplane",
+		"OrgID":     orgID,
+		"CreatedBy": "crossplane-mongodb-provider",
 	}
+}
 
-	input := &secretsmanager.CreateSecretInput{
-		Name:         aws.String(secretName),
-		SecretString: aws.String(string(data)),
-		Description:  aws.String(fmt.Sprintf("MongoDB API credentials for organization %s", OrgID)),
-		Tags: []smtypes.Tag{
-			{Key: aws.String("Provider"), Value: aws.String("mongodb-crossplane")},
-			{Key: aws.String("OrgID"), Value: aws.String(OrgID)},
-			{Key: aws.String("CreatedBy"), Value: aws.String("crossplane-mongodb-provider")},
-		},
+// OrphanedOrgID returns the ID of the deleted organization a secret was
+// retained for, if the secret is tagged as orphaned.
+func OrphanedOrgID(desc *secretsmanager.DescribeSecretOutput) (string, bool) {
+	var orphaned bool
+	var orgID string
+	for _, t := range desc.Tags {
+		switch aws.ToString(t.Key) {
+		case TagOrphaned:
+			orphaned = aws.ToString(t.Value) == "true"
+		case "OrgID":
+			orgID = aws.ToString(t.Value)
+		}
 	}
-	if kmsKeyID != nil {
-		input.KmsKeyId = kmsKeyID
+	return orgID, orphaned
+}
+
+// PutSecret creates or updates a MongoDB API key secret in AWS Secrets Manager.
+// A secret scheduled for deletion is restored before it is updated. A secret
+// retained for a deleted organization is only overwritten for the same
+// organization, and is no longer tagged as orphaned afterwards.
+func (c *Client) PutSecret(ctx context.Context, secretName string, creds MongoDBAPICredentials, format PayloadFormat, opts SecretOptions) (string, error) {
+	data, err := format.Marshal(creds)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot marshal MongoDB credentials")
 	}
 
-	resp, err := c.SecretsManagerClient.CreateSecret(ctx, input)
+	input := createSecretInput(secretName, string(data), creds.OrgID, opts)
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "CreateSecret")
+	resp, err := c.SecretsManagerClient.CreateSecret(callCtx, input)
+	done(err)
 	if err != nil {
+		// Secrets Manager rejects creating a secret under the name of one
+		// scheduled for deletion with an InvalidRequestException.
 		var existsErr *smtypes.ResourceExistsException
-		if errors.As(err, &existsErr) {
-			// Secret exists, update it
-			updateInput := &secretsmanager.UpdateSecretInput{
-				SecretId:     aws.String(secretName),
-				SecretString: aws.String(string(data)),
-			}
-			if kmsKeyID != nil {
-				updateInput.KmsKeyId = kmsKeyID
+		var invalidErr *smtypes.InvalidRequestException
+		if !errors.As(err, &existsErr) && !errors.As(err, &invalidErr) {
+			return "", errors.Wrap(err, "cannot create AWS secret")
+		}
+		desc, descErr := c.DescribeSecret(ctx, secretName)
+		if descErr != nil {
+			return "", errors.Wrap(err, "cannot create AWS secret")
+		}
+		orphanedOrgID, orphaned := OrphanedOrgID(desc)
+		if orphaned && orphanedOrgID != creds.OrgID {
+			return "", errors.Errorf("refusing to overwrite secret %s retained for deleted organization %s", secretName, orphanedOrgID)
+		}
+		if desc.DeletedDate != nil {
+			if err := c.RestoreSecret(ctx, secretName); err != nil {
+				return "", err
 			}
-			respUpdate, err := c.SecretsManagerClient.UpdateSecret(ctx, updateInput)
-			if err != nil {
-				return "", errors.Wrap(err, "cannot update existing AWS secret")
+		}
+		// Secret exists, update it
+		updateInput := &secretsmanager.UpdateSecretInput{
+			SecretId:     aws.String(secretName),
+			SecretString: aws.String(string(data)),
+		}
+		if opts.KMSKeyID != nil {
+			updateInput.KmsKeyId = opts.KMSKeyID
+		}
+		callCtx, done := track(ctx, metrics.ServiceSecretsManager, "UpdateSecret")
+		respUpdate, err := c.SecretsManagerClient.UpdateSecret(callCtx, updateInput)
+		done(err)
+		if err != nil {
+			return "", errors.Wrap(err, "cannot update existing AWS secret")
+		}
+		resp = &secretsmanager.CreateSecretOutput{ARN: respUpdate.ARN}
+		if orphaned {
+			if err := c.UntagSecret(ctx, secretName, []string{TagOrphaned, TagOrphanedAt}); err != nil {
+				return "", err
 			}
-			return aws.ToString(respUpdate.ARN), nil
 		}
+	}
+
+	if opts.ResourcePolicy != nil {
+		if err := c.PutResourcePolicy(ctx, secretName, *opts.ResourcePolicy); err != nil {
+			return "", err
+		}
+	}
+	return aws.ToString(resp.ARN), nil
+}
+
+// CopySecret copies the value of a secret to a new secret, e.g. to rename it.
+// It fails if a different secret already exists under the new name.
+func (c *Client) CopySecret(ctx context.Context, from, to, orgID string, opts SecretOptions) (string, error) {
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "GetSecretValue")
+	src, err := c.SecretsManagerClient.GetSecretValue(callCtx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(from)})
+	done(err)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get secret from AWS Secrets Manager")
+	}
+
+	callCtx, done = track(ctx, metrics.ServiceSecretsManager, "CreateSecret")
+	resp, err := c.SecretsManagerClient.CreateSecret(callCtx, createSecretInput(to, aws.ToString(src.SecretString), orgID, opts))
+	done(err)
+	var existsErr *smtypes.ResourceExistsException
+	if errors.As(err, &existsErr) {
+		// A previous copy may have succeeded before the old secret could be
+		// deleted.
+		callCtx, done := track(ctx, metrics.ServiceSecretsManager, "GetSecretValue")
+		dst, getErr := c.SecretsManagerClient.GetSecretValue(callCtx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(to)})
+		done(getErr)
+		if getErr != nil || aws.ToString(dst.SecretString) != aws.ToString(src.SecretString) {
+			return "", errors.Errorf("cannot copy secret %s: a different secret %s already exists", from, to)
+		}
+		return aws.ToString(dst.ARN), nil
+	}
+	if err != nil {
 		return "", errors.Wrap(err, "cannot create AWS secret")
 	}
 
+	if opts.ResourcePolicy != nil {
+		if err := c.PutResourcePolicy(ctx, to, *opts.ResourcePolicy); err != nil {
+			return "", err
+		}
+	}
 	return aws.ToString(resp.ARN), nil
 }
 
+func createSecretInput(secretName, value, orgID string, opts SecretOptions) *secretsmanager.CreateSecretInput {
+	tags := DefaultTags(orgID)
+	for k, v := range opts.Tags {
+		tags[k] = v
+	}
+	input := &secretsmanager.CreateSecretInput{
+		Name:         aws.String(secretName),
+		SecretString: aws.String(value),
+		Description:  aws.String(fmt.Sprintf("MongoDB API credentials for organization %s", orgID)),
+		Tags:         toTags(tags),
+	}
+	if opts.KMSKeyID != nil {
+		input.KmsKeyId = opts.KMSKeyID
+	}
+	if len(opts.ReplicaRegions) > 0 {
+		input.AddReplicaRegions = toReplicaRegions(opts.ReplicaRegions)
+	}
+	return input
+}
+
 // GetSecret retrieves and decrypts a secret from AWS Secrets Manager.
 func (c *Client) GetSecret(ctx context.Context, secretName string) (*MongoDBAPICredentials, error) {
+	return c.GetSecretWithFormat(ctx, secretName, PayloadFormat{})
+}
+
+// GetSecretWithFormat retrieves and decrypts a secret written with the given
+// payload format.
+func (c *Client) GetSecretWithFormat(ctx context.Context, secretName string, format PayloadFormat) (*MongoDBAPICredentials, error) {
 	input := &secretsmanager.GetSecretValueInput{
 		SecretId: aws.String(secretName),
 	}
-	resp, err := c.SecretsManagerClient.GetSecretValue(ctx, input)
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "GetSecretValue")
+	resp, err := c.SecretsManagerClient.GetSecretValue(callCtx, input)
+	done(err)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get secret from AWS Secrets Manager")
 	}
@@ -100,34 +381,486 @@ func (c *Client) GetSecret(ctx context.Context, secretName string) (*MongoDBAPIC
 		return nil, errors.New("secret string is nil")
 	}
 
-	var creds MongoDBAPICredentials
-	if err := json.Unmarshal([]byte(*resp.SecretString), &creds); err != nil {
+	creds, err := format.Unmarshal([]byte(*resp.SecretString))
+	if err != nil {
 		return nil, errors.Wrap(err, "cannot unmarshal AWS secret JSON")
 	}
-	return &creds, nil
+	return creds, nil
 }
 
 // DescribeSecret returns secret metadata including ARN.
 func (c *Client) DescribeSecret(ctx context.Context, secretName string) (*secretsmanager.DescribeSecretOutput, error) {
-	return c.SecretsManagerClient.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "DescribeSecret")
+	out, err := c.SecretsManagerClient.DescribeSecret(callCtx, &secretsmanager.DescribeSecretInput{
 		SecretId: aws.String(secretName),
 	})
+	done(err)
+	return out, err
 }
 
-// DeleteSecret deletes a secret from AWS Secrets Manager.
-func (c *Client) DeleteSecret(ctx context.Context, secretName string, forceDelete bool) error {
+// DefaultRecoveryWindowInDays is how long a deleted secret can be restored
+// unless configured otherwise.
+const DefaultRecoveryWindowInDays = 7
+
+// DeleteSecret deletes a secret from AWS Secrets Manager. Unless forced, the
+// secret can be restored during the recovery window. A window of 0 uses
+// DefaultRecoveryWindowInDays.
+func (c *Client) DeleteSecret(ctx context.Context, secretName string, forceDelete bool, recoveryWindowInDays int64) error {
 	input := &secretsmanager.DeleteSecretInput{
 		SecretId: aws.String(secretName),
 	}
 	if forceDelete {
 		input.ForceDeleteWithoutRecovery = aws.Bool(true)
 	} else {
-		input.RecoveryWindowInDays = aws.Int64(7)
+		if recoveryWindowInDays == 0 {
+			recoveryWindowInDays = DefaultRecoveryWindowInDays
+		}
+		input.RecoveryWindowInDays = aws.Int64(recoveryWindowInDays)
 	}
 
-	_, err := c.SecretsManagerClient.DeleteSecret(ctx, input)
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "DeleteSecret")
+	_, err := c.SecretsManagerClient.DeleteSecret(callCtx, input)
+	done(err)
 	if err != nil {
 		return errors.Wrap(err, "cannot delete secret from AWS Secrets Manager")
 	}
 	return nil
 }
+
+// RestoreSecret cancels the scheduled deletion of a secret in AWS Secrets
+// Manager.
+func (c *Client) RestoreSecret(ctx context.Context, secretName string) error {
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "RestoreSecret")
+	_, err := c.SecretsManagerClient.RestoreSecret(callCtx, &secretsmanager.RestoreSecretInput{
+		SecretId: aws.String(secretName),
+	})
+	done(err)
+	if err != nil {
+		return errors.Wrap(err, "cannot restore secret in AWS Secrets Manager")
+	}
+	return nil
+}
+
+// TagSecret adds or overwrites tags on a secret in AWS Secrets Manager.
+func (c *Client) TagSecret(ctx context.Context, secretName string, tags map[string]string) error {
+	input := &secretsmanager.TagResourceInput{
+		SecretId: aws.String(secretName),
+		Tags:     toTags(tags),
+	}
+
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "TagResource")
+	_, err := c.SecretsManagerClient.TagResource(callCtx, input)
+	done(err)
+	if err != nil {
+		return errors.Wrap(err, "cannot tag secret in AWS Secrets Manager")
+	}
+	return nil
+}
+
+// UntagSecret removes tags from a secret in AWS Secrets Manager.
+func (c *Client) UntagSecret(ctx context.Context, secretName string, keys []string) error {
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "UntagResource")
+	_, err := c.SecretsManagerClient.UntagResource(callCtx, &secretsmanager.UntagResourceInput{
+		SecretId: aws.String(secretName),
+		TagKeys:  keys,
+	})
+	done(err)
+	if err != nil {
+		return errors.Wrap(err, "cannot untag secret in AWS Secrets Manager")
+	}
+	return nil
+}
+
+// GetResourcePolicy returns the resource policy attached to a secret, or nil
+// if there is none.
+func (c *Client) GetResourcePolicy(ctx context.Context, secretName string) (*string, error) {
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "GetResourcePolicy")
+	out, err := c.SecretsManagerClient.GetResourcePolicy(callCtx, &secretsmanager.GetResourcePolicyInput{
+		SecretId: aws.String(secretName),
+	})
+	done(err)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get resource policy of secret in AWS Secrets Manager")
+	}
+	return out.ResourcePolicy, nil
+}
+
+// PutResourcePolicy attaches a resource policy to a secret, replacing any
+// existing one.
+func (c *Client) PutResourcePolicy(ctx context.Context, secretName, policy string) error {
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "PutResourcePolicy")
+	_, err := c.SecretsManagerClient.PutResourcePolicy(callCtx, &secretsmanager.PutResourcePolicyInput{
+		SecretId:          aws.String(secretName),
+		ResourcePolicy:    aws.String(policy),
+		BlockPublicPolicy: aws.Bool(true),
+	})
+	done(err)
+	if err != nil {
+		return errors.Wrap(err, "cannot put resource policy of secret in AWS Secrets Manager")
+	}
+	return nil
+}
+
+// DeleteResourcePolicy removes the resource policy attached to a secret.
+func (c *Client) DeleteResourcePolicy(ctx context.Context, secretName string) error {
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "DeleteResourcePolicy")
+	_, err := c.SecretsManagerClient.DeleteResourcePolicy(callCtx, &secretsmanager.DeleteResourcePolicyInput{
+		SecretId: aws.String(secretName),
+	})
+	done(err)
+	if err != nil {
+		return errors.Wrap(err, "cannot delete resource policy of secret in AWS Secrets Manager")
+	}
+	return nil
+}
+
+// ReplicateSecret replicates a secret to additional regions.
+func (c *Client) ReplicateSecret(ctx context.Context, secretName string, regions []ReplicaRegion) error {
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "ReplicateSecretToRegions")
+	_, err := c.SecretsManagerClient.ReplicateSecretToRegions(callCtx, &secretsmanager.ReplicateSecretToRegionsInput{
+		SecretId:          aws.String(secretName),
+		AddReplicaRegions: toReplicaRegions(regions),
+	})
+	done(err)
+	if err != nil {
+		return errors.Wrap(err, "cannot replicate secret in AWS Secrets Manager")
+	}
+	return nil
+}
+
+// RemoveReplicaRegions deletes the replicas of a secret in the given regions.
+func (c *Client) RemoveReplicaRegions(ctx context.Context, secretName string, regions []string) error {
+	callCtx, done := track(ctx, metrics.ServiceSecretsManager, "RemoveRegionsFromReplication")
+	_, err := c.SecretsManagerClient.RemoveRegionsFromReplication(callCtx, &secretsmanager.RemoveRegionsFromReplicationInput{
+		SecretId:             aws.String(secretName),
+		RemoveReplicaRegions: regions,
+	})
+	done(err)
+	if err != nil {
+		return errors.Wrap(err, "cannot remove replica regions of secret in AWS Secrets Manager")
+	}
+	return nil
+}
+
+// DiffReplicaRegions compares the replicas of a secret with the desired ones.
+// It returns the regions to add and the regions to remove.
+func DiffReplicaRegions(current []smtypes.ReplicationStatusType, desired []ReplicaRegion) ([]ReplicaRegion, []string) {
+	existing := map[string]bool{}
+	for _, r := range current {
+		existing[aws.ToString(r.Region)] = true
+	}
+	wanted := map[string]bool{}
+	var add []ReplicaRegion
+	for _, r := range desired {
+		wanted[r.Region] = true
+		if !existing[r.Region] {
+			add = append(add, r)
+		}
+	}
+	var remove []string
+	for _, r := range current {
+		if region := aws.ToString(r.Region); !wanted[region] {
+			remove = append(remove, region)
+		}
+	}
+	return add, remove
+}
+
+func toReplicaRegions(regions []ReplicaRegion) []smtypes.ReplicaRegionType {
+	out := make([]smtypes.ReplicaRegionType, 0, len(regions))
+	for _, r := range regions {
+		out = append(out, smtypes.ReplicaRegionType{Region: aws.String(r.Region), KmsKeyId: r.KMSKeyID})
+	}
+	return out
+}
+
+// DiffTags compares the tags of a secret with the desired ones. It returns
+// the tags to set and the keys to remove. Tags reserved by AWS are ignored.
+func DiffTags(current []smtypes.Tag, desired map[string]string) (map[string]string, []string) {
+	set := map[string]string{}
+	var remove []string
+	existing := map[string]string{}
+	for _, t := range current {
+		k := aws.ToString(t.Key)
+		if strings.HasPrefix(k, "aws:") {
+			continue
+		}
+		existing[k] = aws.ToString(t.Value)
+		if _, ok := desired[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	for k, v := range desired {
+		if cur, ok := existing[k]; !ok || cur != v {
+			set[k] = v
+		}
+	}
+	sort.Strings(remove)
+	return set, remove
+}
+
+// PolicyEqual reports whether two JSON policy documents are semantically
+// equal. AWS may return a policy reformatted.
+func PolicyEqual(a, b string) bool {
+	var x, y interface{}
+	if json.Unmarshal([]byte(a), &x) != nil || json.Unmarshal([]byte(b), &y) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func toTags(tags map[string]string) []smtypes.Tag {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]smtypes.Tag, 0, len(tags))
+	for _, k := range keys {
+		out = append(out, smtypes.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return out
+}
+
+// ValidateKey checks that Secrets Manager can encrypt secrets in the region of
+// the client with a KMS key on behalf of the provider. It returns the ARN of
+// the key.
+func (c *Client) ValidateKey(ctx context.Context, keyID string) (string, error) {
+	callCtx, done := track(ctx, metrics.ServiceKMS, "DescribeKey")
+	out, err := c.KMSClient.DescribeKey(callCtx, &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	done(err)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot describe KMS key %s", keyID)
+	}
+
+	md := out.KeyMetadata
+	keyARN := aws.ToString(md.Arn)
+	switch {
+	case md.KeyState != kmstypes.KeyStateEnabled:
+		return keyARN, errors.Errorf("KMS key %s is %s", keyARN, md.KeyState)
+	case md.KeyUsage != kmstypes.KeyUsageTypeEncryptDecrypt || md.KeySpec != kmstypes.KeySpecSymmetricDefault:
+		return keyARN, errors.Errorf("KMS key %s is not a symmetric encryption key", keyARN)
+	}
+	if parsed, err := arn.Parse(keyARN); err == nil && parsed.Region != c.Region {
+		return keyARN, errors.Errorf("KMS key %s is in region %s instead of %s", keyARN, parsed.Region, c.Region)
+	}
+
+	// A dry run checks that the provider is allowed to use the key.
+	callCtx, done = track(ctx, metrics.ServiceKMS, "GenerateDataKey")
+	_, err = c.KMSClient.GenerateDataKey(callCtx, &kms.GenerateDataKeyInput{
+		KeyId:   md.Arn,
+		KeySpec: kmstypes.DataKeySpecAes256,
+		DryRun:  aws.Bool(true),
+	})
+	var dryRunErr *kmstypes.DryRunOperationException
+	if errors.As(err, &dryRunErr) {
+		err = nil
+	}
+	done(err)
+	if err != nil {
+		return keyARN, errors.Wrapf(err, "cannot use KMS key %s", keyARN)
+	}
+	return keyARN, nil
+}
+
+// CheckKeyPolicy checks that the default policy of a KMS key allows the
+// principal to perform the actions. Actions the policy grants to the root of
+// the principal's account are assumed to be delegated to IAM, whose policies
+// are not checked.
+func (c *Client) CheckKeyPolicy(ctx context.Context, keyID, principalARN string, actions []string) error {
+	callCtx, done := track(ctx, metrics.ServiceKMS, "GetKeyPolicy")
+	out, err := c.KMSClient.GetKeyPolicy(callCtx, &kms.GetKeyPolicyInput{
+		KeyId:      aws.String(keyID),
+		PolicyName: aws.String("default"),
+	})
+	done(err)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get policy of KMS key %s", keyID)
+	}
+	missing, err := MissingKeyActions(aws.ToString(out.Policy), principalARN, actions)
+	if err != nil {
+		return errors.Wrapf(err, "cannot parse policy of KMS key %s", keyID)
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("policy of KMS key %s does not allow %s to %s", keyID, principalARN, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// NewKeyStore returns a KeyStore backed by AWS KMS in the given region.
+func NewKeyStore(ctx context.Context, region string) (KeyStore, error) {
+	return NewClient(ctx, region)
+}
+
+// policyStatement is a statement of an IAM policy document. Principal and
+// Action may be a single value or a list.
+type policyStatement struct {
+	Effect    string          `json:"Effect"`
+	Principal json.RawMessage `json:"Principal"`
+	Action    json.RawMessage `json:"Action"`
+}
+
+// MissingKeyActions returns the actions that a key policy does not allow the
+// principal to perform. Conditions of the policy are ignored.
+func MissingKeyActions(policy, principalARN string, actions []string) ([]string, error) {
+	doc := struct {
+		Statement json.RawMessage `json:"Statement"`
+	}{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+	var statements []policyStatement
+	if err := unmarshalOneOrMany(doc.Statement, &statements); err != nil {
+		return nil, err
+	}
+
+	principals := []string{"*", principalARN}
+	if parsed, err := arn.Parse(principalARN); err == nil {
+		principals = append(principals, parsed.AccountID, fmt.Sprintf("arn:%s:iam::%s:root", parsed.Partition, parsed.AccountID))
+	}
+
+	allowed := map[string]bool{}
+	denied := map[string]bool{}
+	for _, st := range statements {
+		if !matchesPrincipal(st.Principal, principals) {
+			continue
+		}
+		var stActions []string
+		if err := unmarshalOneOrMany(st.Action, &stActions); err != nil {
+			return nil, err
+		}
+		for _, a := range actions {
+			if !matchesAction(stActions, a) {
+				continue
+			}
+			if strings.EqualFold(st.Effect, "Deny") {
+				denied[a] = true
+			} else {
+				allowed[a] = true
+			}
+		}
+	}
+
+	var missing []string
+	for _, a := range actions {
+		if !allowed[a] || denied[a] {
+			missing = append(missing, a)
+		}
+	}
+	return missing, nil
+}
+
+// matchesPrincipal reports whether the Principal of a statement names one of
+// the principals.
+func matchesPrincipal(raw json.RawMessage, principals []string) bool {
+	var all string
+	if json.Unmarshal(raw, &all) == nil {
+		return all == "*"
+	}
+	byType := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &byType); err != nil {
+		return false
+	}
+	var names []string
+	if err := unmarshalOneOrMany(byType["AWS"], &names); err != nil {
+		return false
+	}
+	for _, n := range names {
+		for _, p := range principals {
+			if n == p {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesAction reports whether one of the action patterns of a statement
+// matches the action.
+func matchesAction(patterns []string, action string) bool {
+	for _, p := range patterns {
+		if p == "*" || strings.EqualFold(p, action) {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(p, "*"); ok && strings.HasPrefix(strings.ToLower(action), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// unmarshalOneOrMany decodes a JSON value that is either a single element or
+// a list of elements into a slice.
+func unmarshalOneOrMany[T any](raw json.RawMessage, out *[]T) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] == '[' {
+		return json.Unmarshal(raw, out)
+	}
+	var one T
+	if err := json.Unmarshal(raw, &one); err != nil {
+		return err
+	}
+	*out = []T{one}
+	return nil
+}
+
+// IsNotFound reports whether an error was caused by a secret that does not
+// exist.
+func IsNotFound(err error) bool {
+	var notFoundErr *smtypes.ResourceNotFoundException
+	return errors.As(err, &notFoundErr)
+}
+
+// track starts a span and a timer for a single AWS API call. The returned
+// function records the outcome of the call.
+func track(ctx context.Context, service, operation string) (context.Context, func(error)) {
+	ctx, span := tracing.StartSpan(ctx, service, operation)
+	start := time.Now()
+	return ctx, func(err error) {
+		code := statusCode(err)
+		metrics.ObserveRequest(service, operation, code, errorType(err), time.Since(start))
+		tracing.EndSpan(span, code, err)
+	}
+}
+
+// statusCode extracts the HTTP status code from an AWS SDK error. It returns
+// 0 when no response was received.
+func statusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.HTTPStatusCode()
+	}
+	return 0
+}
+
+// errorType classifies an AWS SDK error for metrics.
+func errorType(err error) string {
+	if err == nil {
+		return metrics.ErrorTypeNone
+	}
+	if IsNotFound(err) {
+		return metrics.ErrorTypeNotFound
+	}
+	var existsErr *smtypes.ResourceExistsException
+	if errors.As(err, &existsErr) {
+		return metrics.ErrorTypeConflict
+	}
+	var paramsErr smithy.InvalidParamsError
+	if errors.As(err, &paramsErr) {
+		return metrics.ErrorTypeOther
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorFault() == smithy.FaultServer {
+		return metrics.ErrorTypeRetryable
+	}
+	code := statusCode(err)
+	if code == 0 || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError {
+		return metrics.ErrorTypeRetryable
+	}
+	return metrics.ErrorTypeOther
+}
@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPayloadFormat(t *testing.T) {
+	creds := MongoDBAPICredentials{
+		PublicKey:  "public",
+		PrivateKey: "private",
+		OrgID:      "orgID123",
+		APIKeyID:   "keyID123",
+		Roles:      []string{"ORG_OWNER"},
+		BaseURL:    "https://cloud.mongodb.com/api/atlas/v1.0",
+		CreatedAt:  "2025-01-01T00:00:00Z",
+	}
+
+	tests := []struct {
+		name   string
+		format PayloadFormat
+		want   *MongoDBAPICredentials
+	}{
+		{
+			name: "AllFields",
+			want: &creds,
+		},
+		{
+			name:   "SelectedFields",
+			format: PayloadFormat{Fields: []string{FieldOrgID}},
+			want:   &MongoDBAPICredentials{PublicKey: "public", PrivateKey: "private", OrgID: "orgID123"},
+		},
+		{
+			name:   "CustomKeyNames",
+			format: PayloadFormat{KeyNames: map[string]string{FieldPublicKey: "username", FieldPrivateKey: "password"}},
+			want:   &creds,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.format.Marshal(creds)
+			if err != nil {
+				t.Fatalf("PayloadFormat.Marshal() error = %v", err)
+			}
+			got, err := tt.format.Unmarshal(data)
+			if err != nil {
+				t.Fatalf("PayloadFormat.Unmarshal() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("PayloadFormat round trip -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPayloadFormat_UnmarshalLegacy(t *testing.T) {
+	format := PayloadFormat{KeyNames: map[string]string{FieldPublicKey: "username", FieldPrivateKey: "password"}}
+	got, err := format.Unmarshal([]byte(`{"publicKey": "public", "privateKey": "private"}`))
+	if err != nil {
+		t.Fatalf("PayloadFormat.Unmarshal() error = %v", err)
+	}
+	want := &MongoDBAPICredentials{PublicKey: "public", PrivateKey: "private"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PayloadFormat.Unmarshal() -want, +got:\n%s", diff)
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	tests := []struct {
+		name       string
+		current    []smtypes.Tag
+		desired    map[string]string
+		wantSet    map[string]string
+		wantRemove []string
+	}{
+		{
+			name:    "UpToDate",
+			current: []smtypes.Tag{{Key: aws.String("a"), Value: aws.String("1")}},
+			desired: map[string]string{"a": "1"},
+			wantSet: map[string]string{},
+		},
+		{
+			name: "Changed",
+			current: []smtypes.Tag{
+				{Key: aws.String("a"), Value: aws.String("1")},
+				{Key: aws.String("b"), Value: aws.String("2")},
+				{Key: aws.String("aws:reserved"), Value: aws.String("x")},
+			},
+			desired:    map[string]string{"a": "3", "c": "4"},
+			wantSet:    map[string]string{"a": "3", "c": "4"},
+			wantRemove: []string{"b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, remove := DiffTags(tt.current, tt.desired)
+			if diff := cmp.Diff(tt.wantSet, set); diff != "" {
+				t.Errorf("DiffTags() set -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRemove, remove); diff != "" {
+				t.Errorf("DiffTags() remove -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffReplicaRegions(t *testing.T) {
+	replica := func(region string) smtypes.ReplicationStatusType {
+		return smtypes.ReplicationStatusType{Region: aws.String(region), Status: smtypes.StatusTypeInSync}
+	}
+
+	tests := []struct {
+		name       string
+		current    []smtypes.ReplicationStatusType
+		desired    []ReplicaRegion
+		wantAdd    []ReplicaRegion
+		wantRemove []string
+	}{
+		{
+			name: "NoReplicas",
+		},
+		{
+			name:    "InSync",
+			current: []smtypes.ReplicationStatusType{replica("eu-west-1"), replica("us-east-1")},
+			desired: []ReplicaRegion{{Region: "us-east-1"}, {Region: "eu-west-1"}},
+		},
+		{
+			name:    "Add",
+			current: []smtypes.ReplicationStatusType{replica("eu-west-1")},
+			desired: []ReplicaRegion{{Region: "eu-west-1"}, {Region: "us-east-1", KMSKeyID: aws.String("key123")}},
+			wantAdd: []ReplicaRegion{{Region: "us-east-1", KMSKeyID: aws.String("key123")}},
+		},
+		{
+			name:       "Remove",
+			current:    []smtypes.ReplicationStatusType{replica("eu-west-1"), replica("us-east-1")},
+			desired:    []ReplicaRegion{{Region: "eu-west-1"}},
+			wantRemove: []string{"us-east-1"},
+		},
+		{
+			name:       "RemoveAll",
+			current:    []smtypes.ReplicationStatusType{replica("eu-west-1")},
+			wantRemove: []string{"eu-west-1"},
+		},
+		{
+			name:       "Replace",
+			current:    []smtypes.ReplicationStatusType{replica("eu-west-1")},
+			desired:    []ReplicaRegion{{Region: "us-east-1"}},
+			wantAdd:    []ReplicaRegion{{Region: "us-east-1"}},
+			wantRemove: []string{"eu-west-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := DiffReplicaRegions(tt.current, tt.desired)
+			if diff := cmp.Diff(tt.wantAdd, add); diff != "" {
+				t.Errorf("DiffReplicaRegions() add -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRemove, remove); diff != "" {
+				t.Errorf("DiffReplicaRegions() remove -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOrphanedOrgID(t *testing.T) {
+	tests := []struct {
+		name         string
+		tags         map[string]string
+		wantOrgID    string
+		wantOrphaned bool
+	}{
+		{
+			name:      "Managed",
+			tags:      DefaultTags("orgID123"),
+			wantOrgID: "orgID123",
+		},
+		{
+			name:         "Orphaned",
+			tags:         map[string]string{"OrgID": "orgID123", TagOrphaned: "true", TagOrphanedAt: "2024-01-01T00:00:00Z"},
+			wantOrgID:    "orgID123",
+			wantOrphaned: true,
+		},
+		{
+			name: "NoTags",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgID, orphaned := OrphanedOrgID(&secretsmanager.DescribeSecretOutput{Tags: toTags(tt.tags)})
+			if orgID != tt.wantOrgID {
+				t.Errorf("OrphanedOrgID() orgID = %q, want %q", orgID, tt.wantOrgID)
+			}
+			if orphaned != tt.wantOrphaned {
+				t.Errorf("OrphanedOrgID() orphaned = %v, want %v", orphaned, tt.wantOrphaned)
+			}
+		})
+	}
+}
+
+func TestMissingKeyActions(t *testing.T) {
+	role := "arn:aws:iam::123456789012:role/atlas-kms"
+	actions := []string{"kms:Encrypt", "kms:Decrypt", "kms:DescribeKey"}
+
+	tests := []struct {
+		name   string
+		policy string
+		want   []string
+	}{
+		{
+			name: "RoleAllowed",
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111111111111:root","arn:aws:iam::123456789012:role/atlas-kms"]},
+				"Action":["kms:Encrypt","kms:Decrypt","kms:DescribeKey"],"Resource":"*"}]}`,
+		},
+		{
+			name:   "DelegatedToIAM",
+			policy: `{"Statement":{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}}`,
+		},
+		{
+			name:   "OtherPrincipal",
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::111111111111:root"},"Action":"kms:*","Resource":"*"}]}`,
+			want:   actions,
+		},
+		{
+			name: "PartiallyAllowed",
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/atlas-kms"},"Action":["kms:Describe*","kms:Decrypt"],"Resource":"*"},
+				{"Effect":"Deny","Principal":"*","Action":"kms:Decrypt","Resource":"*"}]}`,
+			want: []string{"kms:Encrypt", "kms:Decrypt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MissingKeyActions(tt.policy, role, actions)
+			if err != nil {
+				t.Fatalf("MissingKeyActions() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("MissingKeyActions() -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
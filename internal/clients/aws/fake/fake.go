@@ -0,0 +1,369 @@
+// Package fake contains an in-memory aws.SecretStore for tests.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/pkg/errors"
+
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+)
+
+var _ awsclient.SecretStore = &SecretStore{}
+
+// Secret is a secret held by a SecretStore.
+type Secret struct {
+	Value          string
+	ARN            string
+	KMSKeyID       *string
+	Tags           map[string]string
+	ResourcePolicy *string
+	// Replicas maps replica regions to their KMS key.
+	Replicas    map[string]*string
+	CreatedDate time.Time
+	DeletedDate *time.Time
+}
+
+// SecretStore is an in-memory aws.SecretStore. Failures can be injected per
+// method name, e.g. Errors["PutSecret"].
+type SecretStore struct {
+	mu sync.Mutex
+
+	Secrets map[string]*Secret
+	Errors  map[string]error
+
+	// Calls records the names of the called methods.
+	Calls []string
+}
+
+// NewSecretStore returns an empty SecretStore.
+func NewSecretStore() *SecretStore {
+	return &SecretStore{Secrets: map[string]*Secret{}, Errors: map[string]error{}}
+}
+
+// NotFound returns the error Secrets Manager returns for a missing secret.
+func NotFound(name string) error {
+	return &smtypes.ResourceNotFoundException{Message: aws.String(fmt.Sprintf("secret %s not found", name))}
+}
+
+// call records a call and returns the injected failure, if any.
+func (s *SecretStore) call(method string) error {
+	s.Calls = append(s.Calls, method)
+	return s.Errors[method]
+}
+
+// get returns a secret that is not scheduled for deletion.
+func (s *SecretStore) get(name string) (*Secret, error) {
+	secret, ok := s.Secrets[name]
+	if !ok || secret.DeletedDate != nil {
+		return nil, NotFound(name)
+	}
+	return secret, nil
+}
+
+// PutSecret creates or updates a secret. Like Secrets Manager, it restores a
+// secret scheduled for deletion and refuses to overwrite one retained for
+// another organization.
+func (s *SecretStore) PutSecret(_ context.Context, secretName string, creds awsclient.MongoDBAPICredentials, format awsclient.PayloadFormat, opts awsclient.SecretOptions) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("PutSecret"); err != nil {
+		return "", err
+	}
+	data, err := format.Marshal(creds)
+	if err != nil {
+		return "", err
+	}
+	if secret, ok := s.Secrets[secretName]; ok {
+		if secret.Tags[awsclient.TagOrphaned] == "true" && secret.Tags["OrgID"] != creds.OrgID {
+			return "", errors.Errorf("refusing to overwrite secret %s retained for deleted organization %s", secretName, secret.Tags["OrgID"])
+		}
+		secret.DeletedDate = nil
+		secret.Value = string(data)
+		delete(secret.Tags, awsclient.TagOrphaned)
+		delete(secret.Tags, awsclient.TagOrphanedAt)
+		return secret.ARN, nil
+	}
+	tags := awsclient.DefaultTags(creds.OrgID)
+	for k, v := range opts.Tags {
+		tags[k] = v
+	}
+	return s.create(secretName, string(data), tags, opts), nil
+}
+
+func (s *SecretStore) create(name, value string, tags map[string]string, opts awsclient.SecretOptions) string {
+	secret := &Secret{
+		Value:          value,
+		ARN:            "arn:aws:secretsmanager:eu-central-1:123456789012:secret:" + name,
+		KMSKeyID:       opts.KMSKeyID,
+		Tags:           tags,
+		ResourcePolicy: opts.ResourcePolicy,
+		Replicas:       map[string]*string{},
+		CreatedDate:    time.Now(),
+	}
+	for _, r := range opts.ReplicaRegions {
+		secret.Replicas[r.Region] = r.KMSKeyID
+	}
+	s.Secrets[name] = secret
+	return secret.ARN
+}
+
+// CopySecret copies a secret to a new name. Like Secrets Manager, it fails if
+// a secret with the new name is scheduled for deletion.
+func (s *SecretStore) CopySecret(_ context.Context, from, to, orgID string, opts awsclient.SecretOptions) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("CopySecret"); err != nil {
+		return "", err
+	}
+	src, err := s.get(from)
+	if err != nil {
+		return "", err
+	}
+	if dst, ok := s.Secrets[to]; ok {
+		if dst.DeletedDate != nil {
+			return "", &smtypes.InvalidRequestException{Message: aws.String(fmt.Sprintf("secret %s is scheduled for deletion", to))}
+		}
+		if dst.Value != src.Value {
+			return "", errors.Errorf("cannot copy secret %s: a different secret %s already exists", from, to)
+		}
+		return dst.ARN, nil
+	}
+	tags := awsclient.DefaultTags(orgID)
+	for k, v := range opts.Tags {
+		tags[k] = v
+	}
+	return s.create(to, src.Value, tags, opts), nil
+}
+
+// GetSecret reads a secret with the default payload format.
+func (s *SecretStore) GetSecret(ctx context.Context, secretName string) (*awsclient.MongoDBAPICredentials, error) {
+	return s.GetSecretWithFormat(ctx, secretName, awsclient.PayloadFormat{})
+}
+
+// GetSecretWithFormat reads a secret.
+func (s *SecretStore) GetSecretWithFormat(_ context.Context, secretName string, format awsclient.PayloadFormat) (*awsclient.MongoDBAPICredentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("GetSecret"); err != nil {
+		return nil, err
+	}
+	secret, err := s.get(secretName)
+	if err != nil {
+		return nil, err
+	}
+	return format.Unmarshal([]byte(secret.Value))
+}
+
+// DescribeSecret returns the metadata of a secret. Secrets scheduled for
+// deletion are described with their deletion date.
+func (s *SecretStore) DescribeSecret(_ context.Context, secretName string) (*secretsmanager.DescribeSecretOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("DescribeSecret"); err != nil {
+		return nil, err
+	}
+	secret, ok := s.Secrets[secretName]
+	if !ok {
+		return nil, NotFound(secretName)
+	}
+	out := &secretsmanager.DescribeSecretOutput{
+		Name:        aws.String(secretName),
+		ARN:         aws.String(secret.ARN),
+		KmsKeyId:    secret.KMSKeyID,
+		CreatedDate: aws.Time(secret.CreatedDate),
+		DeletedDate: secret.DeletedDate,
+	}
+	keys := make([]string, 0, len(secret.Tags))
+	for k := range secret.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		out.Tags = append(out.Tags, smtypes.Tag{Key: aws.String(k), Value: aws.String(secret.Tags[k])})
+	}
+	regions := make([]string, 0, len(secret.Replicas))
+	for r := range secret.Replicas {
+		regions = append(regions, r)
+	}
+	sort.Strings(regions)
+	for _, r := range regions {
+		out.ReplicationStatus = append(out.ReplicationStatus, smtypes.ReplicationStatusType{
+			Region:   aws.String(r),
+			KmsKeyId: secret.Replicas[r],
+			Status:   smtypes.StatusTypeInSync,
+		})
+	}
+	return out, nil
+}
+
+// DeleteSecret deletes a secret immediately or schedules its deletion.
+func (s *SecretStore) DeleteSecret(_ context.Context, secretName string, forceDelete bool, _ int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("DeleteSecret"); err != nil {
+		return err
+	}
+	secret, err := s.get(secretName)
+	if err != nil {
+		return err
+	}
+	if len(secret.Replicas) > 0 {
+		return errors.Errorf("cannot delete secret %s while it is replicated", secretName)
+	}
+	if forceDelete {
+		delete(s.Secrets, secretName)
+		return nil
+	}
+	now := time.Now()
+	secret.DeletedDate = &now
+	return nil
+}
+
+// RestoreSecret cancels the scheduled deletion of a secret.
+func (s *SecretStore) RestoreSecret(_ context.Context, secretName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("RestoreSecret"); err != nil {
+		return err
+	}
+	secret, ok := s.Secrets[secretName]
+	if !ok {
+		return NotFound(secretName)
+	}
+	secret.DeletedDate = nil
+	return nil
+}
+
+// TagSecret adds or overwrites tags of a secret.
+func (s *SecretStore) TagSecret(_ context.Context, secretName string, tags map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("TagSecret"); err != nil {
+		return err
+	}
+	secret, err := s.get(secretName)
+	if err != nil {
+		return err
+	}
+	for k, v := range tags {
+		secret.Tags[k] = v
+	}
+	return nil
+}
+
+// UntagSecret removes tags of a secret.
+func (s *SecretStore) UntagSecret(_ context.Context, secretName string, keys []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("UntagSecret"); err != nil {
+		return err
+	}
+	secret, err := s.get(secretName)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		delete(secret.Tags, k)
+	}
+	return nil
+}
+
+// GetResourcePolicy returns the resource policy of a secret.
+func (s *SecretStore) GetResourcePolicy(_ context.Context, secretName string) (*string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("GetResourcePolicy"); err != nil {
+		return nil, err
+	}
+	secret, err := s.get(secretName)
+	if err != nil {
+		return nil, err
+	}
+	return secret.ResourcePolicy, nil
+}
+
+// PutResourcePolicy sets the resource policy of a secret.
+func (s *SecretStore) PutResourcePolicy(_ context.Context, secretName, policy string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("PutResourcePolicy"); err != nil {
+		return err
+	}
+	secret, err := s.get(secretName)
+	if err != nil {
+		return err
+	}
+	secret.ResourcePolicy = aws.String(policy)
+	return nil
+}
+
+// DeleteResourcePolicy removes the resource policy of a secret.
+func (s *SecretStore) DeleteResourcePolicy(_ context.Context, secretName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("DeleteResourcePolicy"); err != nil {
+		return err
+	}
+	secret, err := s.get(secretName)
+	if err != nil {
+		return err
+	}
+	secret.ResourcePolicy = nil
+	return nil
+}
+
+// ReplicateSecret adds replica regions to a secret.
+func (s *SecretStore) ReplicateSecret(_ context.Context, secretName string, regions []awsclient.ReplicaRegion) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("ReplicateSecret"); err != nil {
+		return err
+	}
+	secret, err := s.get(secretName)
+	if err != nil {
+		return err
+	}
+	for _, r := range regions {
+		secret.Replicas[r.Region] = r.KMSKeyID
+	}
+	return nil
+}
+
+// ValidateKey returns the ARN of a KMS key in eu-central-1.
+func (s *SecretStore) ValidateKey(_ context.Context, keyID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("ValidateKey"); err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(keyID, "arn:") {
+		return keyID, nil
+	}
+	return "arn:aws:kms:eu-central-1:123456789012:key/" + keyID, nil
+}
+
+// RemoveReplicaRegions removes replica regions of a secret.
+func (s *SecretStore) RemoveReplicaRegions(_ context.Context, secretName string, regions []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.call("RemoveReplicaRegions"); err != nil {
+		return err
+	}
+	secret, err := s.get(secretName)
+	if err != nil {
+		return err
+	}
+	for _, r := range regions {
+		delete(secret.Replicas, r)
+	}
+	return nil
+}
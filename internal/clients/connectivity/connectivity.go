@@ -10,6 +10,10 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/metrics"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
 )
 
 const (
@@ -122,7 +126,11 @@ func (c *Client) requestVPCEndpointStatus(ctx context.Context, accountID string,
 }
 
 // GetVPCEndpointStatus returns whether the VPC endpoint resource already exists
-func (c *Client) GetVPCEndpointStatus(ctx context.Context, accountID string, vpcEndpointID string, region string) (VPCEndpointStatus, error) {
+func (c *Client) GetVPCEndpointStatus(ctx context.Context, accountID string, vpcEndpointID string, region string) (_ VPCEndpointStatus, err error) {
+	ctx, done := track(ctx, "GetVPCEndpointStatus")
+	statusCode := 0
+	defer func() { done(statusCode, err) }()
+
 	resBody, statusCode, err := c.requestVPCEndpointStatus(ctx, accountID, vpcEndpointID, region)
 	if err != nil {
 		return VPCEndpointStatus{}, err
@@ -188,7 +196,11 @@ type CreateVPCEndpointParams struct {
 }
 
 // CreateVPCEndpoint function creates a new VPC endpoint resource
-func (c *Client) CreateVPCEndpoint(ctx context.Context, params CreateVPCEndpointParams) (VPCEndpointResponse, error) {
+func (c *Client) CreateVPCEndpoint(ctx context.Context, params CreateVPCEndpointParams) (_ VPCEndpointResponse, err error) {
+	ctx, done := track(ctx, "CreateVPCEndpoint")
+	statusCode := 0
+	defer func() { done(statusCode, err) }()
+
 	createURL, err := c.getCreateURL()
 	if err != nil {
 		return VPCEndpointResponse{}, err
@@ -223,6 +235,7 @@ func (c *Client) CreateVPCEndpoint(ctx context.Context, params CreateVPCEndpoint
 		return VPCEndpointResponse{}, err
 	}
 	defer res.Body.Close() //nolint:errcheck // ignoring response body error
+	statusCode = res.StatusCode
 
 	resBody, err := io.ReadAll(res.Body)
 
@@ -246,3 +259,29 @@ func (c *Client) CreateVPCEndpoint(ctx context.Context, params CreateVPCEndpoint
 
 	return vpcEndpoint, nil
 }
+
+// track starts a span and a timer for a single call to the VPC endpoint
+// lambdas. The returned function records the outcome of the call.
+func track(ctx context.Context, operation string) (context.Context, func(int, error)) {
+	ctx, span := tracing.StartSpan(ctx, metrics.ServiceConnectivity, operation)
+	start := time.Now()
+	return ctx, func(statusCode int, err error) {
+		metrics.ObserveRequest(metrics.ServiceConnectivity, operation, statusCode, errorType(statusCode, err), time.Since(start))
+		tracing.EndSpan(span, statusCode, err)
+	}
+}
+
+func errorType(statusCode int, err error) string {
+	switch {
+	case err == nil:
+		return metrics.ErrorTypeNone
+	case errors.Is(err, ErrNotFound):
+		return metrics.ErrorTypeNotFound
+	case statusCode == http.StatusConflict:
+		return metrics.ErrorTypeConflict
+	case statusCode == 0, statusCode == http.StatusTooManyRequests, statusCode >= http.StatusInternalServerError:
+		return metrics.ErrorTypeRetryable
+	default:
+		return metrics.ErrorTypeOther
+	}
+}
@@ -0,0 +1,168 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// BackupPolicyItem is a rule of a backup policy.
+type BackupPolicyItem struct {
+	ID                string `json:"id,omitempty"`
+	FrequencyType     string `json:"frequencyType"`
+	FrequencyInterval int    `json:"frequencyInterval"`
+	RetentionUnit     string `json:"retentionUnit"`
+	RetentionValue    int    `json:"retentionValue"`
+}
+
+// BackupPolicy is the snapshot policy of a cluster.
+type BackupPolicy struct {
+	ID          string             `json:"id,omitempty"`
+	PolicyItems []BackupPolicyItem `json:"policyItems"`
+}
+
+// BackupCopySetting copies snapshots to another region.
+type BackupCopySetting struct {
+	CloudProvider     string   `json:"cloudProvider"`
+	RegionName        string   `json:"regionName"`
+	ReplicationSpecID string   `json:"replicationSpecId"`
+	ShouldCopyOplogs  bool     `json:"shouldCopyOplogs"`
+	Frequencies       []string `json:"frequencies"`
+}
+
+// BackupExport exports snapshots to an export bucket.
+type BackupExport struct {
+	ExportBucketID string `json:"exportBucketId,omitempty"`
+	FrequencyType  string `json:"frequencyType,omitempty"`
+}
+
+// BackupSchedule is the cloud backup schedule of a cluster.
+type BackupSchedule struct {
+	ClusterID                         string              `json:"clusterId,omitempty"`
+	ClusterName                       string              `json:"clusterName,omitempty"`
+	AutoExportEnabled                 *bool               `json:"autoExportEnabled,omitempty"`
+	Export                            *BackupExport       `json:"export,omitempty"`
+	CopySettings                      []BackupCopySetting `json:"copySettings"`
+	NextSnapshot                      *time.Time          `json:"nextSnapshot,omitempty"`
+	Policies                          []BackupPolicy      `json:"policies,omitempty"`
+	ReferenceHourOfDay                *int                `json:"referenceHourOfDay,omitempty"`
+	ReferenceMinuteOfHour             *int                `json:"referenceMinuteOfHour,omitempty"`
+	RestoreWindowDays                 *int                `json:"restoreWindowDays,omitempty"`
+	UseOrgAndGroupNamesInExportPrefix *bool               `json:"useOrgAndGroupNamesInExportPrefix,omitempty"`
+}
+
+// GetBackupSchedule returns the backup schedule of a cluster.
+func (c *client) GetBackupSchedule(ctx context.Context, projectID, clusterName string) (*BackupSchedule, error) {
+	schedule := &BackupSchedule{}
+	if err := c.makeRequest(ctx, "GetBackupSchedule", http.MethodGet, fmt.Sprintf("/groups/%s/clusters/%s/backup/schedule", projectID, clusterName), nil, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// UpdateBackupSchedule updates the backup schedule of a cluster. The policy
+// items of a policy are replaced.
+func (c *client) UpdateBackupSchedule(ctx context.Context, projectID, clusterName string, schedule BackupSchedule) (*BackupSchedule, error) {
+	updated := &BackupSchedule{}
+	if err := c.makeRequest(ctx, "UpdateBackupSchedule", http.MethodPatch, fmt.Sprintf("/groups/%s/clusters/%s/backup/schedule", projectID, clusterName), schedule, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteBackupSchedule removes all policy items from the backup schedule of a
+// cluster.
+func (c *client) DeleteBackupSchedule(ctx context.Context, projectID, clusterName string) error {
+	return c.makeRequest(ctx, "DeleteBackupSchedule", http.MethodDelete, fmt.Sprintf("/groups/%s/clusters/%s/backup/schedule", projectID, clusterName), nil, nil)
+}
+
+// Snapshot states reported by Atlas.
+const (
+	SnapshotStatusQueued     = "queued"
+	SnapshotStatusInProgress = "inProgress"
+	SnapshotStatusCompleted  = "completed"
+	SnapshotStatusFailed     = "failed"
+)
+
+// Snapshot is a cloud backup snapshot of a cluster.
+type Snapshot struct {
+	ID               string     `json:"id,omitempty"`
+	Description      string     `json:"description,omitempty"`
+	RetentionInDays  int        `json:"retentionInDays,omitempty"`
+	Status           string     `json:"status,omitempty"`
+	SnapshotType     string     `json:"snapshotType,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt        *time.Time `json:"expiresAt,omitempty"`
+	StorageSizeBytes int64      `json:"storageSizeBytes,omitempty"`
+	MongodVersion    string     `json:"mongodVersion,omitempty"`
+}
+
+// RestoreJob restores a snapshot of a cluster.
+type RestoreJob struct {
+	ID                    string     `json:"id,omitempty"`
+	DeliveryType          string     `json:"deliveryType"`
+	SnapshotID            string     `json:"snapshotId,omitempty"`
+	TargetGroupID         string     `json:"targetGroupId,omitempty"`
+	TargetClusterName     string     `json:"targetClusterName,omitempty"`
+	PointInTimeUTCSeconds *int64     `json:"pointInTimeUTCSeconds,omitempty"`
+	Cancelled             bool       `json:"cancelled,omitempty"`
+	Expired               bool       `json:"expired,omitempty"`
+	Failed                *bool      `json:"failed,omitempty"`
+	FinishedAt            *time.Time `json:"finishedAt,omitempty"`
+	Timestamp             *time.Time `json:"timestamp,omitempty"`
+	DeliveryURL           []string   `json:"deliveryUrl,omitempty"`
+}
+
+// CreateSnapshot takes an on-demand snapshot of a cluster.
+func (c *client) CreateSnapshot(ctx context.Context, projectID, clusterName string, snapshot Snapshot) (*Snapshot, error) {
+	created := &Snapshot{}
+	if err := c.makeRequest(ctx, "CreateSnapshot", http.MethodPost, fmt.Sprintf("/groups/%s/clusters/%s/backup/snapshots", projectID, clusterName), snapshot, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetSnapshot returns a snapshot of a cluster.
+func (c *client) GetSnapshot(ctx context.Context, projectID, clusterName, snapshotID string) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	if err := c.makeRequest(ctx, "GetSnapshot", http.MethodGet, fmt.Sprintf("/groups/%s/clusters/%s/backup/snapshots/%s", projectID, clusterName, snapshotID), nil, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// UpdateSnapshotRetention changes how many days after its creation a
+// snapshot expires.
+func (c *client) UpdateSnapshotRetention(ctx context.Context, projectID, clusterName, snapshotID string, days int) error {
+	payload := map[string]interface{}{"retentionUnit": "DAYS", "retentionValue": days}
+	return c.makeRequest(ctx, "UpdateSnapshotRetention", http.MethodPatch, fmt.Sprintf("/groups/%s/clusters/%s/backup/snapshots/%s", projectID, clusterName, snapshotID), payload, nil)
+}
+
+// DeleteSnapshot deletes a snapshot of a cluster.
+func (c *client) DeleteSnapshot(ctx context.Context, projectID, clusterName, snapshotID string) error {
+	return c.makeRequest(ctx, "DeleteSnapshot", http.MethodDelete, fmt.Sprintf("/groups/%s/clusters/%s/backup/snapshots/%s", projectID, clusterName, snapshotID), nil, nil)
+}
+
+// CreateRestoreJob restores a snapshot of a cluster.
+func (c *client) CreateRestoreJob(ctx context.Context, projectID, clusterName string, job RestoreJob) (*RestoreJob, error) {
+	created := &RestoreJob{}
+	if err := c.makeRequest(ctx, "CreateRestoreJob", http.MethodPost, fmt.Sprintf("/groups/%s/clusters/%s/backup/restoreJobs", projectID, clusterName), job, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetRestoreJob returns a restore job of a cluster.
+func (c *client) GetRestoreJob(ctx context.Context, projectID, clusterName, jobID string) (*RestoreJob, error) {
+	job := &RestoreJob{}
+	if err := c.makeRequest(ctx, "GetRestoreJob", http.MethodGet, fmt.Sprintf("/groups/%s/clusters/%s/backup/restoreJobs/%s", projectID, clusterName, jobID), nil, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// CancelRestoreJob cancels a restore job that has not finished yet.
+func (c *client) CancelRestoreJob(ctx context.Context, projectID, clusterName, jobID string) error {
+	return c.makeRequest(ctx, "CancelRestoreJob", http.MethodDelete, fmt.Sprintf("/groups/%s/clusters/%s/backup/restoreJobs/%s", projectID, clusterName, jobID), nil, nil)
+}
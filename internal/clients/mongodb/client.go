@@ -6,21 +6,82 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/icholy/digest"
 	"github.com/pkg/errors"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/metrics"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
 )
 
-// Service defines operations for managing MongoDB Atlas organizations.
+// Service defines operations for managing MongoDB Atlas organizations and
+// their members.
 type Service interface {
 	CreateOrganization(ctx context.Context, input CreateOrganizationInput) (*Organization, APIKeyPair, error)
 	GetOrganization(ctx context.Context, id string) (*Organization, error)
+	FindOrganizationsByName(ctx context.Context, name string) ([]Organization, error)
 	UpdateOrganization(ctx context.Context, input UpdateOrganizationInput) (*Organization, error)
+	GetOrganizationSettings(ctx context.Context, id string) (*OrganizationSettings, error)
+	UpdateOrganizationSettings(ctx context.Context, id string, settings OrganizationSettings) (*OrganizationSettings, error)
+	ListAPIKeyAccessList(ctx context.Context, orgID, keyID string) ([]string, error)
+	AddAPIKeyAccessList(ctx context.Context, orgID, keyID string, cidrBlocks []string) error
+	DeleteAPIKeyAccessListEntry(ctx context.Context, orgID, keyID, cidrBlock string) error
+
+	CreateTeam(ctx context.Context, orgID string, team Team) (*Team, error)
+	GetTeam(ctx context.Context, orgID, teamID string) (*Team, error)
+	RenameTeam(ctx context.Context, orgID, teamID, name string) error
+	DeleteTeam(ctx context.Context, orgID, teamID string) error
+	ListTeamUsers(ctx context.Context, orgID, teamID string) ([]User, error)
+	AddTeamUsers(ctx context.Context, orgID, teamID string, userIDs []string) error
+	RemoveTeamUser(ctx context.Context, orgID, teamID, userID string) error
+	GetUserByName(ctx context.Context, username string) (*User, error)
+	ListOrganizationUsers(ctx context.Context, orgID string) ([]User, error)
+	FindOrganizationUser(ctx context.Context, orgID, username string) (*User, error)
+	UpdateOrganizationUserRoles(ctx context.Context, orgID, userID string, roles []string) error
+	RemoveOrganizationUser(ctx context.Context, orgID, userID string) error
+	CreateInvitation(ctx context.Context, orgID string, invitation Invitation) (*Invitation, error)
+	GetInvitation(ctx context.Context, orgID, invitationID string) (*Invitation, error)
+	UpdateInvitation(ctx context.Context, orgID, invitationID string, roles, teamIDs []string) error
+	DeleteInvitation(ctx context.Context, orgID, invitationID string) error
 	DeleteOrganization(ctx context.Context, id string) error
 	// ADD: Verify organization deletion with child resource checking
 	VerifyOrganizationDeletion(ctx context.Context, id string) error
+	ListOrganizationProjects(ctx context.Context, orgID string) ([]Project, error)
+	DeleteProject(ctx context.Context, id string) error
+
+	ListProjectTeams(ctx context.Context, projectID string) ([]ProjectTeam, error)
+	AddProjectTeams(ctx context.Context, projectID string, teams []ProjectTeam) error
+	UpdateProjectTeamRoles(ctx context.Context, projectID, teamID string, roles []string) error
+	RemoveProjectTeam(ctx context.Context, projectID, teamID string) error
+	CreateCloudProviderAccessRole(ctx context.Context, projectID string) (*CloudProviderAccessRole, error)
+	GetCloudProviderAccessRole(ctx context.Context, projectID, roleID string) (*CloudProviderAccessRole, error)
+	AuthorizeCloudProviderAccessRole(ctx context.Context, projectID, roleID, iamRoleARN string) (*CloudProviderAccessRole, error)
+	DeauthorizeCloudProviderAccessRole(ctx context.Context, projectID, roleID string) error
+	GetEncryptionAtRest(ctx context.Context, projectID string) (*EncryptionAtRest, error)
+	UpdateEncryptionAtRest(ctx context.Context, projectID string, config EncryptionAtRest) (*EncryptionAtRest, error)
+
+	CreateServerlessInstance(ctx context.Context, projectID string, instance ServerlessInstance) (*ServerlessInstance, error)
+	GetServerlessInstance(ctx context.Context, projectID, name string) (*ServerlessInstance, error)
+	UpdateServerlessInstance(ctx context.Context, projectID, name string, instance ServerlessInstance) (*ServerlessInstance, error)
+	DeleteServerlessInstance(ctx context.Context, projectID, name string) error
+	CreateFlexCluster(ctx context.Context, projectID string, cluster FlexCluster) (*FlexCluster, error)
+	GetFlexCluster(ctx context.Context, projectID, name string) (*FlexCluster, error)
+	UpdateFlexCluster(ctx context.Context, projectID, name string, cluster FlexCluster) (*FlexCluster, error)
+	DeleteFlexCluster(ctx context.Context, projectID, name string) error
+
+	GetBackupSchedule(ctx context.Context, projectID, clusterName string) (*BackupSchedule, error)
+	UpdateBackupSchedule(ctx context.Context, projectID, clusterName string, schedule BackupSchedule) (*BackupSchedule, error)
+	DeleteBackupSchedule(ctx context.Context, projectID, clusterName string) error
+	CreateSnapshot(ctx context.Context, projectID, clusterName string, snapshot Snapshot) (*Snapshot, error)
+	GetSnapshot(ctx context.Context, projectID, clusterName, snapshotID string) (*Snapshot, error)
+	UpdateSnapshotRetention(ctx context.Context, projectID, clusterName, snapshotID string, days int) error
+	DeleteSnapshot(ctx context.Context, projectID, clusterName, snapshotID string) error
+	CreateRestoreJob(ctx context.Context, projectID, clusterName string, job RestoreJob) (*RestoreJob, error)
+	GetRestoreJob(ctx context.Context, projectID, clusterName, jobID string) (*RestoreJob, error)
+	CancelRestoreJob(ctx context.Context, projectID, clusterName, jobID string) error
 }
 
 // Credentials stores public/private API keys.
@@ -38,6 +99,37 @@ type Organization struct {
 	Created    time.Time `json:"created,omitempty"`
 }
 
+// Project represents a MongoDB Atlas project (group) within an organization.
+type Project struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	OrgID string `json:"orgId"`
+}
+
+// organizationPage is a single page of the organizations listing.
+type organizationPage struct {
+	Results    []Organization `json:"results"`
+	TotalCount int            `json:"totalCount"`
+}
+
+// accessListEntry is an entry of the access list of an API key.
+type accessListEntry struct {
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// accessListPage is a single page of the access list of an API key.
+type accessListPage struct {
+	Results    []accessListEntry `json:"results"`
+	TotalCount int               `json:"totalCount"`
+}
+
+// projectPage is a single page of the organization projects listing.
+type projectPage struct {
+	Results    []Project `json:"results"`
+	TotalCount int       `json:"totalCount"`
+}
+
 // APIKey describes an API key for creation payload
 type APIKey struct {
 	Description string   `json:"desc"`
@@ -57,8 +149,19 @@ type UpdateOrganizationInput struct {
 	Name string `json:"name,omitempty"`
 }
 
+// OrganizationSettings are the security settings of an organization. Fields
+// that are nil are left unchanged by UpdateOrganizationSettings.
+type OrganizationSettings struct {
+	APIAccessListRequired   *bool   `json:"apiAccessListRequired,omitempty"`
+	MultiFactorAuthRequired *bool   `json:"multiFactorAuthRequired,omitempty"`
+	RestrictEmployeeAccess  *bool   `json:"restrictEmployeeAccess,omitempty"`
+	GenAIFeaturesEnabled    *bool   `json:"genAIFeaturesEnabled,omitempty"`
+	SecurityContact         *string `json:"securityContact,omitempty"`
+}
+
 // APIKeyPair stores public/private keys.
 type APIKeyPair struct {
+	ID         string `json:"id,omitempty"`
 	PublicKey  string `json:"publicKey"`
 	PrivateKey string `json:"privateKey"`
 }
@@ -90,6 +193,14 @@ func (e *RetryableError) Error() string {
 	return fmt.Sprintf("retryable error: %s - %v", e.Msg, e.Err)
 }
 
+// OrganizationExistsError signals an organization that still exists after
+// its deletion was requested.
+type OrganizationExistsError struct{ ID string }
+
+func (e *OrganizationExistsError) Error() string {
+	return fmt.Sprintf("organization %s still exists", e.ID)
+}
+
 // ADD: ConflictError signals resource is in transition
 type ConflictError struct {
 	Err error
@@ -104,19 +215,31 @@ func (e *ConflictError) Error() string {
 type client struct {
 	httpClient  *http.Client
 	baseURL     string
+	baseURLV2   string
 	credentials Credentials
 }
 
+// BaseURL is the Atlas Administration API endpoint used by the client.
+const BaseURL = "https://cloud.mongodb.com/api/atlas/v1.0"
+
+// BaseURLV2 is the versioned Atlas Administration API endpoint. Requests
+// select the version of a resource with the Accept header.
+const BaseURLV2 = "https://cloud.mongodb.com/api/atlas/v2"
+
 // NewService returns a new MongoDB client.
 func NewService(creds Credentials) Service {
 	transport := &digest.Transport{Username: creds.PublicKey, Password: creds.PrivateKey}
 	return &client{
 		httpClient:  &http.Client{Timeout: 30 * time.Second, Transport: transport},
-		baseURL:     "https://cloud.mongodb.com/api/atlas/v1.0",
+		baseURL:     BaseURL,
+		baseURLV2:   BaseURLV2,
 		credentials: creds,
 	}
 }
 
+// projectsPerPage is the page size used when listing projects.
+const projectsPerPage = 500
+
 // ErrNotFound standard error for missing resources.
 var ErrNotFound = errors.New("not found")
 
@@ -142,6 +265,23 @@ func IsConflictError(err error) bool {
 	return false
 }
 
+// IsOrganizationExistsError reports whether an organization whose deletion was
+// requested still exists.
+func IsOrganizationExistsError(err error) bool {
+	var existsErr *OrganizationExistsError
+	return errors.As(err, &existsErr)
+}
+
+// IsUnauthorizedError reports whether the API key is not allowed to access
+// the requested resource.
+func IsUnauthorizedError(err error) bool {
+	var apiErr Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden
+}
+
 // CreateOrgPayload allows to serialize combined org + API key request
 type CreateOrgPayload struct {
 	Name       string `json:"name"`
@@ -178,7 +318,7 @@ func (c *client) CreateOrganization(ctx context.Context, input CreateOrganizatio
 	}
 
 	orgResp := &CreateOrgResponse{}
-	if err := c.makeRequest(ctx, http.MethodPost, "/orgs", payload, orgResp); err != nil {
+	if err := c.makeRequest(ctx, "CreateOrganization", http.MethodPost, "/orgs", payload, orgResp); err != nil {
 		return nil, APIKeyPair{}, errors.Wrap(err, "cannot create organization")
 	}
 
@@ -190,6 +330,7 @@ func (c *client) CreateOrganization(ctx context.Context, input CreateOrganizatio
 	}
 
 	keys := APIKeyPair{
+		ID:         orgResp.APIKey.ID,
 		PublicKey:  orgResp.APIKey.PublicKey,
 		PrivateKey: orgResp.APIKey.PrivateKey,
 	}
@@ -199,31 +340,116 @@ func (c *client) CreateOrganization(ctx context.Context, input CreateOrganizatio
 
 func (c *client) GetOrganization(ctx context.Context, id string) (*Organization, error) {
 	org := &Organization{}
-	if err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("/orgs/%s", id), nil, org); err != nil {
+	if err := c.makeRequest(ctx, "GetOrganization", http.MethodGet, fmt.Sprintf("/orgs/%s", id), nil, org); err != nil {
 		return nil, err
 	}
 	return org, nil
 }
 
+// FindOrganizationsByName returns the organizations visible to the client
+// that are named exactly like the given name.
+func (c *client) FindOrganizationsByName(ctx context.Context, name string) ([]Organization, error) {
+	if name == "" {
+		return nil, errors.New("organization name cannot be empty")
+	}
+
+	var orgs []Organization
+	seen := 0
+	for pageNum := 1; ; pageNum++ {
+		page := &organizationPage{}
+		endpoint := fmt.Sprintf("/orgs?name=%s&itemsPerPage=%d&pageNum=%d", url.QueryEscape(name), projectsPerPage, pageNum)
+		if err := c.makeRequest(ctx, "FindOrganizationsByName", http.MethodGet, endpoint, nil, page); err != nil {
+			return nil, errors.Wrap(err, "cannot list organizations")
+		}
+		// Atlas matches names case insensitively and partially.
+		for _, org := range page.Results {
+			if org.Name == name {
+				orgs = append(orgs, org)
+			}
+		}
+		seen += len(page.Results)
+		if len(page.Results) < projectsPerPage || seen >= page.TotalCount {
+			return orgs, nil
+		}
+	}
+}
+
 func (c *client) UpdateOrganization(ctx context.Context, input UpdateOrganizationInput) (*Organization, error) {
 	org := &Organization{}
 	payload := map[string]interface{}{}
 	if input.Name != "" {
 		payload["name"] = input.Name
 	}
-	if err := c.makeRequest(ctx, http.MethodPatch, fmt.Sprintf("/orgs/%s", input.ID), payload, org); err != nil {
+	if err := c.makeRequest(ctx, "UpdateOrganization", http.MethodPatch, fmt.Sprintf("/orgs/%s", input.ID), payload, org); err != nil {
 		return nil, err
 	}
 	return org, nil
 }
 
+// GetOrganizationSettings returns the security settings of an organization.
+func (c *client) GetOrganizationSettings(ctx context.Context, id string) (*OrganizationSettings, error) {
+	settings := &OrganizationSettings{}
+	if err := c.makeRequest(ctx, "GetOrganizationSettings", http.MethodGet, fmt.Sprintf("/orgs/%s/settings", id), nil, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpdateOrganizationSettings changes the non-nil security settings of an
+// organization.
+func (c *client) UpdateOrganizationSettings(ctx context.Context, id string, settings OrganizationSettings) (*OrganizationSettings, error) {
+	updated := &OrganizationSettings{}
+	if err := c.makeRequest(ctx, "UpdateOrganizationSettings", http.MethodPatch, fmt.Sprintf("/orgs/%s/settings", id), settings, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// ListAPIKeyAccessList returns the CIDR blocks API requests with an
+// organization API key may originate from. Single IP addresses are returned as
+// /32 or /128 blocks.
+func (c *client) ListAPIKeyAccessList(ctx context.Context, orgID, keyID string) ([]string, error) {
+	var cidrBlocks []string
+	for pageNum := 1; ; pageNum++ {
+		page := &accessListPage{}
+		endpoint := fmt.Sprintf("/orgs/%s/apiKeys/%s/accessList?itemsPerPage=%d&pageNum=%d", orgID, keyID, projectsPerPage, pageNum)
+		if err := c.makeRequest(ctx, "ListAPIKeyAccessList", http.MethodGet, endpoint, nil, page); err != nil {
+			return nil, errors.Wrap(err, "cannot list API key access list")
+		}
+		for _, e := range page.Results {
+			cidrBlocks = append(cidrBlocks, e.CIDRBlock)
+		}
+		if len(page.Results) < projectsPerPage || len(cidrBlocks) >= page.TotalCount {
+			return cidrBlocks, nil
+		}
+	}
+}
+
+// AddAPIKeyAccessList adds CIDR blocks to the access list of an organization
+// API key.
+func (c *client) AddAPIKeyAccessList(ctx context.Context, orgID, keyID string, cidrBlocks []string) error {
+	entries := make([]accessListEntry, len(cidrBlocks))
+	for i, b := range cidrBlocks {
+		entries[i] = accessListEntry{CIDRBlock: b}
+	}
+	endpoint := fmt.Sprintf("/orgs/%s/apiKeys/%s/accessList", orgID, keyID)
+	return c.makeRequest(ctx, "AddAPIKeyAccessList", http.MethodPost, endpoint, entries, nil)
+}
+
+// DeleteAPIKeyAccessListEntry removes a CIDR block from the access list of an
+// organization API key.
+func (c *client) DeleteAPIKeyAccessListEntry(ctx context.Context, orgID, keyID, cidrBlock string) error {
+	endpoint := fmt.Sprintf("/orgs/%s/apiKeys/%s/accessList/%s", orgID, keyID, url.PathEscape(cidrBlock))
+	return c.makeRequest(ctx, "DeleteAPIKeyAccessListEntry", http.MethodDelete, endpoint, nil, nil)
+}
+
 // ADD: Enhanced DeleteOrganization with better error handling
 func (c *client) DeleteOrganization(ctx context.Context, id string) error {
 	if id == "" {
 		return errors.New("organization id cannot be empty")
 	}
 	
-	return c.makeRequest(ctx, http.MethodDelete, fmt.Sprintf("/orgs/%s", id), nil, nil)
+	return c.makeRequest(ctx, "DeleteOrganization", http.MethodDelete, fmt.Sprintf("/orgs/%s", id), nil, nil)
 }
 
 // ADD: VerifyOrganizationDeletion checks if organization is deleted
@@ -235,7 +461,7 @@ func (c *client) VerifyOrganizationDeletion(ctx context.Context, id string) erro
 
 	// Try to get the organization
 	org := &Organization{}
-	err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("/orgs/%s", id), nil, org)
+	err := c.makeRequest(ctx, "VerifyOrganizationDeletion", http.MethodGet, fmt.Sprintf("/orgs/%s", id), nil, org)
 	
 	// 404 means successfully deleted
 	if IsNotFoundError(err) {
@@ -248,32 +474,100 @@ func (c *client) VerifyOrganizationDeletion(ctx context.Context, id string) erro
 	}
 	
 	// If we got here, organization still exists
-	return errors.Errorf("organization %s still exists", id)
+	return &OrganizationExistsError{ID: id}
+}
+
+// ListOrganizationProjects returns all projects that belong to an organization.
+func (c *client) ListOrganizationProjects(ctx context.Context, orgID string) ([]Project, error) {
+	if orgID == "" {
+		return nil, errors.New("organization id cannot be empty")
+	}
+
+	var projects []Project
+	for pageNum := 1; ; pageNum++ {
+		page := &projectPage{}
+		endpoint := fmt.Sprintf("/orgs/%s/groups?itemsPerPage=%d&pageNum=%d", orgID, projectsPerPage, pageNum)
+		if err := c.makeRequest(ctx, "ListOrganizationProjects", http.MethodGet, endpoint, nil, page); err != nil {
+			return nil, errors.Wrap(err, "cannot list organization projects")
+		}
+		projects = append(projects, page.Results...)
+		if len(page.Results) < projectsPerPage || len(projects) >= page.TotalCount {
+			return projects, nil
+		}
+	}
+}
+
+// DeleteProject deletes an Atlas project. Atlas rejects the request while the
+// project still contains clusters.
+func (c *client) DeleteProject(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("project id cannot be empty")
+	}
+	return c.makeRequest(ctx, "DeleteProject", http.MethodDelete, fmt.Sprintf("/groups/%s", id), nil, nil)
+}
+
+// makeRequest performs a request against the Atlas API inside a span and
+// records its outcome under the given operation name.
+func (c *client) makeRequest(ctx context.Context, operation, method, endpoint string, payload interface{}, result interface{}) error {
+	ctx, span := tracing.StartSpan(ctx, metrics.ServiceAtlas, operation)
+	start := time.Now()
+	statusCode, err := c.doRequest(ctx, method, c.baseURL+endpoint, "application/json", payload, result)
+	metrics.ObserveRequest(metrics.ServiceAtlas, operation, statusCode, errorType(err), time.Since(start))
+	tracing.EndSpan(span, statusCode, err)
+	return err
+}
+
+// makeVersionedRequest is like makeRequest for resources that are only
+// served by the given version of the versioned Atlas API.
+func (c *client) makeVersionedRequest(ctx context.Context, operation, version, method, endpoint string, payload interface{}, result interface{}) error {
+	ctx, span := tracing.StartSpan(ctx, metrics.ServiceAtlas, operation)
+	start := time.Now()
+	statusCode, err := c.doRequest(ctx, method, c.baseURLV2+endpoint, "application/vnd.atlas."+version+"+json", payload, result)
+	metrics.ObserveRequest(metrics.ServiceAtlas, operation, statusCode, errorType(err), time.Since(start))
+	tracing.EndSpan(span, statusCode, err)
+	return err
+}
+
+// errorType classifies an error returned by doRequest for metrics.
+func errorType(err error) string {
+	switch {
+	case err == nil:
+		return metrics.ErrorTypeNone
+	case IsNotFoundError(err):
+		return metrics.ErrorTypeNotFound
+	case IsConflictError(err):
+		return metrics.ErrorTypeConflict
+	case IsRetryableError(err):
+		return metrics.ErrorTypeRetryable
+	default:
+		return metrics.ErrorTypeOther
+	}
 }
 
-// ADD: Enhanced makeRequest with better error categorization
-func (c *client) makeRequest(ctx context.Context, method, endpoint string, payload interface{}, result interface{}) error {
-	url := c.baseURL + endpoint
+// doRequest sends the request with the given media type and categorizes HTTP
+// errors. It returns the status code of the response, or 0 if none was
+// received.
+func (c *client) doRequest(ctx context.Context, method, url, accept string, payload interface{}, result interface{}) (int, error) {
 	var body io.Reader
 	if payload != nil {
 		j, err := json.Marshal(payload)
 		if err != nil {
-			return errors.Wrap(err, "marshal payload")
+			return 0, errors.Wrap(err, "marshal payload")
 		}
 		body = strings.NewReader(string(j))
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return errors.Wrap(err, "create HTTP request")
+		return 0, errors.Wrap(err, "create HTTP request")
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", accept)
+	req.Header.Set("Accept", accept)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		// Network errors are retryable
-		return &RetryableError{Err: err, Msg: "HTTP request failed (network error)"}
+		return 0, &RetryableError{Err: err, Msg: "HTTP request failed (network error)"}
 	}
 	defer resp.Body.Close()
 
@@ -285,39 +579,39 @@ func (c *client) makeRequest(ctx context.Context, method, endpoint string, paylo
 			// If we can't parse the error, return generic error
 			errorMsg := fmt.Sprintf("HTTP %d: %s", resp.StatusCode, resp.Status)
 			if resp.StatusCode >= 500 {
-				return &RetryableError{Err: errors.New(errorMsg), Msg: "server error"}
+				return resp.StatusCode, &RetryableError{Err: errors.New(errorMsg), Msg: "server error"}
 			}
-			return errors.New(errorMsg)
+			return resp.StatusCode, errors.New(errorMsg)
 		}
 
 		// 404 Not Found - resource doesn't exist (success for deletion)
 		if resp.StatusCode == 404 {
-			return &NotFoundError{Err: apiErr}
+			return resp.StatusCode, &NotFoundError{Err: apiErr}
 		}
 
 		// 409 Conflict - resource in transition (retryable)
 		if resp.StatusCode == 409 {
-			return &ConflictError{Err: apiErr, Msg: "resource in conflict state"}
+			return resp.StatusCode, &ConflictError{Err: apiErr, Msg: "resource in conflict state"}
 		}
 
 		// 429 Too Many Requests - rate limited (retryable)
 		if resp.StatusCode == 429 {
-			return &RetryableError{Err: apiErr, Msg: "rate limited"}
+			return resp.StatusCode, &RetryableError{Err: apiErr, Msg: "rate limited"}
 		}
 
 		// 5xx Server Errors - retryable
 		if resp.StatusCode >= 500 {
-			return &RetryableError{Err: apiErr, Msg: "server error"}
+			return resp.StatusCode, &RetryableError{Err: apiErr, Msg: "server error"}
 		}
 
 		// 4xx Client Errors (except those above) - not retryable
-		return apiErr
+		return resp.StatusCode, apiErr
 	}
 
 	if result != nil && resp.StatusCode != http.StatusNoContent {
 		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return errors.Wrap(err, "decode response")
+			return resp.StatusCode, errors.Wrap(err, "decode response")
 		}
 	}
-	return nil
+	return resp.StatusCode, nil
 }
@@ -0,0 +1,274 @@
+package mongodb
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jarcoal/httpmock"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func TestClient_makeRequestMetrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		operation     string
+		mockHTTP      func()
+		wantErrorType string
+		wantClass     string
+		wantErr       bool
+	}{
+		{
+			name:      "Success",
+			operation: "GetOrganizationSuccess",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs/orgID123",
+					httpmock.NewStringResponder(200, `{"id": "orgID123", "name": "org-name"}`))
+			},
+			wantErrorType: "none",
+			wantClass:     "2xx",
+		},
+		{
+			name:      "NotFound",
+			operation: "GetOrganizationNotFound",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs/orgID123",
+					httpmock.NewStringResponder(404, `{"error": 404, "reason": "Not Found", "detail": "missing"}`))
+			},
+			wantErrorType: "not_found",
+			wantClass:     "4xx",
+			wantErr:       true,
+		},
+		{
+			name:      "Conflict",
+			operation: "GetOrganizationConflict",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs/orgID123",
+					httpmock.NewStringResponder(409, `{"error": 409, "reason": "Conflict", "detail": "busy"}`))
+			},
+			wantErrorType: "conflict",
+			wantClass:     "4xx",
+			wantErr:       true,
+		},
+		{
+			name:      "ServerError",
+			operation: "GetOrganizationServerError",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs/orgID123",
+					httpmock.NewStringResponder(503, `{"error": 503, "reason": "Unavailable", "detail": "down"}`))
+			},
+			wantErrorType: "retryable",
+			wantClass:     "5xx",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewService(Credentials{}).(*client)
+
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			tt.mockHTTP()
+
+			err := c.makeRequest(context.Background(), tt.operation, http.MethodGet, "/orgs/orgID123", nil, &Organization{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("client.makeRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			families, err := metrics.Registry.Gather()
+			if err != nil {
+				t.Fatalf("cannot gather metrics: %v", err)
+			}
+			found := false
+			for _, f := range families {
+				if f.GetName() != "provider_mongodb_external_requests_total" {
+					continue
+				}
+				for _, m := range f.GetMetric() {
+					labels := map[string]string{}
+					for _, l := range m.GetLabel() {
+						labels[l.GetName()] = l.GetValue()
+					}
+					if labels["operation"] == tt.operation {
+						found = true
+						if labels["error_type"] != tt.wantErrorType || labels["status_class"] != tt.wantClass {
+							t.Errorf("client.makeRequest() recorded %v, want error_type=%s status_class=%s", labels, tt.wantErrorType, tt.wantClass)
+						}
+					}
+				}
+			}
+			if !found {
+				t.Errorf("client.makeRequest() did not record a request for operation %s", tt.operation)
+			}
+		})
+	}
+}
+
+func TestClient_FindOrganizationsByName(t *testing.T) {
+	tests := []struct {
+		name     string
+		mockHTTP func()
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name: "ExactMatch",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs",
+					httpmock.NewStringResponder(200, `{"results": [{"id": "o1", "name": "test-org"}, {"id": "o2", "name": "test-org-2"}, {"id": "o3", "name": "Test-Org"}], "totalCount": 3}`))
+			},
+			want: []string{"o1"},
+		},
+		{
+			name: "NoMatch",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs",
+					httpmock.NewStringResponder(200, `{"results": [], "totalCount": 0}`))
+			},
+		},
+		{
+			name: "Unauthorized",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs",
+					httpmock.NewStringResponder(401, `{"error": 401, "reason": "Unauthorized", "detail": "denied"}`))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewService(Credentials{})
+
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			tt.mockHTTP()
+
+			got, err := c.FindOrganizationsByName(context.Background(), "test-org")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("client.FindOrganizationsByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var ids []string
+			for _, o := range got {
+				ids = append(ids, o.ID)
+			}
+			if diff := cmp.Diff(tt.want, ids); diff != "" {
+				t.Errorf("client.FindOrganizationsByName() -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient_ListOrganizationProjects(t *testing.T) {
+	tests := []struct {
+		name     string
+		mockHTTP func()
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name: "Empty",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs/orgID123/groups",
+					httpmock.NewStringResponder(200, `{"results": [], "totalCount": 0}`))
+			},
+		},
+		{
+			name: "SinglePage",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs/orgID123/groups",
+					httpmock.NewStringResponder(200, `{"results": [{"id": "p1", "name": "one"}, {"id": "p2", "name": "two"}], "totalCount": 2}`))
+			},
+			want: []string{"p1", "p2"},
+		},
+		{
+			name: "OrgNotFound",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v1.0/orgs/orgID123/groups",
+					httpmock.NewStringResponder(404, `{"error": 404, "reason": "Not Found", "detail": "missing"}`))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewService(Credentials{})
+
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			tt.mockHTTP()
+
+			got, err := c.ListOrganizationProjects(context.Background(), "orgID123")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("client.ListOrganizationProjects() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var ids []string
+			for _, p := range got {
+				ids = append(ids, p.ID)
+			}
+			if diff := cmp.Diff(tt.want, ids); diff != "" {
+				t.Errorf("client.ListOrganizationProjects() -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient_GetFlexCluster(t *testing.T) {
+	tests := []struct {
+		name     string
+		mockHTTP func()
+		want     *FlexCluster
+		wantErr  bool
+	}{
+		{
+			name: "VersionedAPI",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v2/groups/p1/flexClusters/dev",
+					func(req *http.Request) (*http.Response, error) {
+						if got := req.Header.Get("Accept"); got != "application/vnd.atlas.2024-11-13+json" {
+							return httpmock.NewStringResponse(406, `{"error": 406, "reason": "Not Acceptable", "detail": "`+got+`"}`), nil
+						}
+						return httpmock.NewStringResponse(200, `{"id": "c1", "name": "dev", "stateName": "IDLE", "connectionStrings": {"standardSrv": "mongodb+srv://dev.example.mongodb.net"}}`), nil
+					})
+			},
+			want: &FlexCluster{ID: "c1", Name: "dev", StateName: ClusterStateIdle, ConnectionStrings: &ConnectionStrings{StandardSrv: "mongodb+srv://dev.example.mongodb.net"}},
+		},
+		{
+			name: "NotFound",
+			mockHTTP: func() {
+				httpmock.RegisterResponder(http.MethodGet, "https://cloud.mongodb.com/api/atlas/v2/groups/p1/flexClusters/dev",
+					httpmock.NewStringResponder(404, `{"error": 404, "reason": "Not Found", "detail": "missing"}`))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewService(Credentials{})
+
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+
+			tt.mockHTTP()
+
+			got, err := c.GetFlexCluster(context.Background(), "p1", "dev")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("client.GetFlexCluster() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("client.GetFlexCluster() -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// flexClusterAPIVersion is the version of the Atlas API that serves flex
+// clusters.
+const flexClusterAPIVersion = "2024-11-13"
+
+// Cluster states reported by Atlas.
+const (
+	ClusterStateIdle      = "IDLE"
+	ClusterStateCreating  = "CREATING"
+	ClusterStateUpdating  = "UPDATING"
+	ClusterStateDeleting  = "DELETING"
+	ClusterStateRepairing = "REPAIRING"
+)
+
+// ConnectionStrings are the connection strings of a cluster.
+type ConnectionStrings struct {
+	Standard    string `json:"standard,omitempty"`
+	StandardSrv string `json:"standardSrv,omitempty"`
+}
+
+// ServerlessProviderSettings configures the cloud provider of a serverless
+// instance.
+type ServerlessProviderSettings struct {
+	ProviderName        string `json:"providerName,omitempty"`
+	BackingProviderName string `json:"backingProviderName,omitempty"`
+	RegionName          string `json:"regionName,omitempty"`
+}
+
+// ServerlessBackupOptions configures the backups of a serverless instance.
+type ServerlessBackupOptions struct {
+	ServerlessContinuousBackupEnabled *bool `json:"serverlessContinuousBackupEnabled,omitempty"`
+}
+
+// ServerlessInstance is a serverless instance of a project.
+type ServerlessInstance struct {
+	ID                           string                      `json:"id,omitempty"`
+	Name                         string                      `json:"name,omitempty"`
+	ProviderSettings             *ServerlessProviderSettings `json:"providerSettings,omitempty"`
+	ServerlessBackupOptions      *ServerlessBackupOptions    `json:"serverlessBackupOptions,omitempty"`
+	TerminationProtectionEnabled *bool                       `json:"terminationProtectionEnabled,omitempty"`
+	StateName                    string                      `json:"stateName,omitempty"`
+	MongoDBVersion               string                      `json:"mongoDBVersion,omitempty"`
+	ConnectionStrings            *ConnectionStrings          `json:"connectionStrings,omitempty"`
+}
+
+// FlexProviderSettings configures the cloud provider of a flex cluster.
+type FlexProviderSettings struct {
+	BackingProviderName string `json:"backingProviderName,omitempty"`
+	RegionName          string `json:"regionName,omitempty"`
+}
+
+// FlexBackupSettings are the backup settings of a flex cluster.
+type FlexBackupSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+// FlexCluster is a flex cluster of a project.
+type FlexCluster struct {
+	ID                           string                `json:"id,omitempty"`
+	Name                         string                `json:"name,omitempty"`
+	ProviderSettings             *FlexProviderSettings `json:"providerSettings,omitempty"`
+	TerminationProtectionEnabled *bool                 `json:"terminationProtectionEnabled,omitempty"`
+	StateName                    string                `json:"stateName,omitempty"`
+	MongoDBVersion               string                `json:"mongoDBVersion,omitempty"`
+	BackupSettings               *FlexBackupSettings   `json:"backupSettings,omitempty"`
+	ConnectionStrings            *ConnectionStrings    `json:"connectionStrings,omitempty"`
+}
+
+// CreateServerlessInstance creates a serverless instance.
+func (c *client) CreateServerlessInstance(ctx context.Context, projectID string, instance ServerlessInstance) (*ServerlessInstance, error) {
+	created := &ServerlessInstance{}
+	if err := c.makeRequest(ctx, "CreateServerlessInstance", http.MethodPost, fmt.Sprintf("/groups/%s/serverless", projectID), instance, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetServerlessInstance returns a serverless instance.
+func (c *client) GetServerlessInstance(ctx context.Context, projectID, name string) (*ServerlessInstance, error) {
+	instance := &ServerlessInstance{}
+	if err := c.makeRequest(ctx, "GetServerlessInstance", http.MethodGet, fmt.Sprintf("/groups/%s/serverless/%s", projectID, name), nil, instance); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+// UpdateServerlessInstance updates the backup options and termination
+// protection of a serverless instance.
+func (c *client) UpdateServerlessInstance(ctx context.Context, projectID, name string, instance ServerlessInstance) (*ServerlessInstance, error) {
+	updated := &ServerlessInstance{}
+	if err := c.makeRequest(ctx, "UpdateServerlessInstance", http.MethodPatch, fmt.Sprintf("/groups/%s/serverless/%s", projectID, name), instance, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteServerlessInstance deletes a serverless instance. Atlas rejects the
+// request while termination protection is enabled.
+func (c *client) DeleteServerlessInstance(ctx context.Context, projectID, name string) error {
+	return c.makeRequest(ctx, "DeleteServerlessInstance", http.MethodDelete, fmt.Sprintf("/groups/%s/serverless/%s", projectID, name), nil, nil)
+}
+
+// CreateFlexCluster creates a flex cluster.
+func (c *client) CreateFlexCluster(ctx context.Context, projectID string, cluster FlexCluster) (*FlexCluster, error) {
+	created := &FlexCluster{}
+	if err := c.makeVersionedRequest(ctx, "CreateFlexCluster", flexClusterAPIVersion, http.MethodPost, fmt.Sprintf("/groups/%s/flexClusters", projectID), cluster, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetFlexCluster returns a flex cluster.
+func (c *client) GetFlexCluster(ctx context.Context, projectID, name string) (*FlexCluster, error) {
+	cluster := &FlexCluster{}
+	if err := c.makeVersionedRequest(ctx, "GetFlexCluster", flexClusterAPIVersion, http.MethodGet, fmt.Sprintf("/groups/%s/flexClusters/%s", projectID, name), nil, cluster); err != nil {
+		return nil, err
+	}
+	return cluster, nil
+}
+
+// UpdateFlexCluster updates the termination protection of a flex cluster.
+func (c *client) UpdateFlexCluster(ctx context.Context, projectID, name string, cluster FlexCluster) (*FlexCluster, error) {
+	updated := &FlexCluster{}
+	if err := c.makeVersionedRequest(ctx, "UpdateFlexCluster", flexClusterAPIVersion, http.MethodPatch, fmt.Sprintf("/groups/%s/flexClusters/%s", projectID, name), cluster, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteFlexCluster deletes a flex cluster. Atlas rejects the request while
+// termination protection is enabled.
+func (c *client) DeleteFlexCluster(ctx context.Context, projectID, name string) error {
+	return c.makeVersionedRequest(ctx, "DeleteFlexCluster", flexClusterAPIVersion, http.MethodDelete, fmt.Sprintf("/groups/%s/flexClusters/%s", projectID, name), nil, nil)
+}
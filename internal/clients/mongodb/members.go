@@ -0,0 +1,197 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Team is a team of an organization.
+type Team struct {
+	ID        string   `json:"id,omitempty"`
+	Name      string   `json:"name"`
+	Usernames []string `json:"usernames,omitempty"`
+}
+
+// User is an Atlas user.
+type User struct {
+	ID       string     `json:"id"`
+	Username string     `json:"username"`
+	Roles    []UserRole `json:"roles,omitempty"`
+	TeamIDs  []string   `json:"teamIds,omitempty"`
+}
+
+// UserRole is a role of a user in an organization or project.
+type UserRole struct {
+	OrgID    string `json:"orgId,omitempty"`
+	GroupID  string `json:"groupId,omitempty"`
+	RoleName string `json:"roleName"`
+}
+
+// OrganizationRoles returns the roles of a user in an organization.
+func (u User) OrganizationRoles(orgID string) []string {
+	var roles []string
+	for _, r := range u.Roles {
+		if r.OrgID == orgID {
+			roles = append(roles, r.RoleName)
+		}
+	}
+	return roles
+}
+
+// Invitation invites a user to an organization.
+type Invitation struct {
+	ID              string     `json:"id,omitempty"`
+	Username        string     `json:"username,omitempty"`
+	Roles           []string   `json:"roles"`
+	TeamIDs         []string   `json:"teamIds,omitempty"`
+	InviterUsername string     `json:"inviterUsername,omitempty"`
+	CreatedAt       *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt       *time.Time `json:"expiresAt,omitempty"`
+}
+
+// page is a single page of a listing.
+type page[T any] struct {
+	Results    []T `json:"results"`
+	TotalCount int `json:"totalCount"`
+}
+
+// listAll returns all pages of a listing.
+func listAll[T any](ctx context.Context, c *client, operation, endpoint string) ([]T, error) {
+	var all []T
+	for pageNum := 1; ; pageNum++ {
+		p := &page[T]{}
+		if err := c.makeRequest(ctx, operation, http.MethodGet, fmt.Sprintf("%s?itemsPerPage=%d&pageNum=%d", endpoint, projectsPerPage, pageNum), nil, p); err != nil {
+			return nil, err
+		}
+		all = append(all, p.Results...)
+		if len(p.Results) < projectsPerPage || len(all) >= p.TotalCount {
+			return all, nil
+		}
+	}
+}
+
+// CreateTeam creates a team. Atlas requires at least one member.
+func (c *client) CreateTeam(ctx context.Context, orgID string, team Team) (*Team, error) {
+	created := &Team{}
+	if err := c.makeRequest(ctx, "CreateTeam", http.MethodPost, fmt.Sprintf("/orgs/%s/teams", orgID), team, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetTeam returns a team.
+func (c *client) GetTeam(ctx context.Context, orgID, teamID string) (*Team, error) {
+	team := &Team{}
+	if err := c.makeRequest(ctx, "GetTeam", http.MethodGet, fmt.Sprintf("/orgs/%s/teams/%s", orgID, teamID), nil, team); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+// RenameTeam renames a team.
+func (c *client) RenameTeam(ctx context.Context, orgID, teamID, name string) error {
+	return c.makeRequest(ctx, "RenameTeam", http.MethodPatch, fmt.Sprintf("/orgs/%s/teams/%s", orgID, teamID), map[string]string{"name": name}, nil)
+}
+
+// DeleteTeam deletes a team.
+func (c *client) DeleteTeam(ctx context.Context, orgID, teamID string) error {
+	return c.makeRequest(ctx, "DeleteTeam", http.MethodDelete, fmt.Sprintf("/orgs/%s/teams/%s", orgID, teamID), nil, nil)
+}
+
+// ListTeamUsers returns the members of a team.
+func (c *client) ListTeamUsers(ctx context.Context, orgID, teamID string) ([]User, error) {
+	return listAll[User](ctx, c, "ListTeamUsers", fmt.Sprintf("/orgs/%s/teams/%s/users", orgID, teamID))
+}
+
+// AddTeamUsers adds users to a team. The users must be members of the
+// organization.
+func (c *client) AddTeamUsers(ctx context.Context, orgID, teamID string, userIDs []string) error {
+	users := make([]map[string]string, len(userIDs))
+	for i, id := range userIDs {
+		users[i] = map[string]string{"id": id}
+	}
+	return c.makeRequest(ctx, "AddTeamUsers", http.MethodPost, fmt.Sprintf("/orgs/%s/teams/%s/users", orgID, teamID), users, nil)
+}
+
+// RemoveTeamUser removes a user from a team.
+func (c *client) RemoveTeamUser(ctx context.Context, orgID, teamID, userID string) error {
+	return c.makeRequest(ctx, "RemoveTeamUser", http.MethodDelete, fmt.Sprintf("/orgs/%s/teams/%s/users/%s", orgID, teamID, userID), nil, nil)
+}
+
+// GetUserByName returns an Atlas user by username.
+func (c *client) GetUserByName(ctx context.Context, username string) (*User, error) {
+	user := &User{}
+	if err := c.makeRequest(ctx, "GetUserByName", http.MethodGet, "/users/byName/"+url.PathEscape(username), nil, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ListOrganizationUsers returns the members of an organization.
+func (c *client) ListOrganizationUsers(ctx context.Context, orgID string) ([]User, error) {
+	users, err := listAll[User](ctx, c, "ListOrganizationUsers", fmt.Sprintf("/orgs/%s/users", orgID))
+	return users, errors.Wrap(err, "cannot list organization users")
+}
+
+// FindOrganizationUser returns the member of an organization with the given
+// username. Usernames are compared case insensitively.
+func (c *client) FindOrganizationUser(ctx context.Context, orgID, username string) (*User, error) {
+	users, err := c.ListOrganizationUsers(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if strings.EqualFold(users[i].Username, username) {
+			return &users[i], nil
+		}
+	}
+	return nil, &NotFoundError{Err: Error{Code: http.StatusNotFound, Reason: "Not Found", Detail: fmt.Sprintf("user %s is not a member of organization %s", username, orgID)}}
+}
+
+// UpdateOrganizationUserRoles replaces the roles of a member of an
+// organization.
+func (c *client) UpdateOrganizationUserRoles(ctx context.Context, orgID, userID string, roles []string) error {
+	payload := map[string][]string{"orgRoles": roles}
+	return c.makeRequest(ctx, "UpdateOrganizationUserRoles", http.MethodPut, fmt.Sprintf("/orgs/%s/users/%s/roles", orgID, userID), payload, nil)
+}
+
+// RemoveOrganizationUser removes a user from an organization.
+func (c *client) RemoveOrganizationUser(ctx context.Context, orgID, userID string) error {
+	return c.makeRequest(ctx, "RemoveOrganizationUser", http.MethodDelete, fmt.Sprintf("/orgs/%s/users/%s", orgID, userID), nil, nil)
+}
+
+// CreateInvitation invites a user to an organization.
+func (c *client) CreateInvitation(ctx context.Context, orgID string, invitation Invitation) (*Invitation, error) {
+	created := &Invitation{}
+	if err := c.makeRequest(ctx, "CreateInvitation", http.MethodPost, fmt.Sprintf("/orgs/%s/invites", orgID), invitation, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetInvitation returns a pending invitation. Accepted and expired
+// invitations are not found.
+func (c *client) GetInvitation(ctx context.Context, orgID, invitationID string) (*Invitation, error) {
+	invitation := &Invitation{}
+	if err := c.makeRequest(ctx, "GetInvitation", http.MethodGet, fmt.Sprintf("/orgs/%s/invites/%s", orgID, invitationID), nil, invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// UpdateInvitation changes the roles and teams of a pending invitation.
+func (c *client) UpdateInvitation(ctx context.Context, orgID, invitationID string, roles, teamIDs []string) error {
+	payload := Invitation{Roles: roles, TeamIDs: teamIDs}
+	return c.makeRequest(ctx, "UpdateInvitation", http.MethodPatch, fmt.Sprintf("/orgs/%s/invites/%s", orgID, invitationID), payload, nil)
+}
+
+// DeleteInvitation revokes a pending invitation.
+func (c *client) DeleteInvitation(ctx context.Context, orgID, invitationID string) error {
+	return c.makeRequest(ctx, "DeleteInvitation", http.MethodDelete, fmt.Sprintf("/orgs/%s/invites/%s", orgID, invitationID), nil, nil)
+}
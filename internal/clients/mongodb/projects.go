@@ -0,0 +1,122 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ProjectTeam is a team assigned to a project.
+type ProjectTeam struct {
+	TeamID    string   `json:"teamId"`
+	RoleNames []string `json:"roleNames"`
+}
+
+// ListProjectTeams returns the teams assigned to a project.
+func (c *client) ListProjectTeams(ctx context.Context, projectID string) ([]ProjectTeam, error) {
+	return listAll[ProjectTeam](ctx, c, "ListProjectTeams", fmt.Sprintf("/groups/%s/teams", projectID))
+}
+
+// AddProjectTeams assigns teams to a project.
+func (c *client) AddProjectTeams(ctx context.Context, projectID string, teams []ProjectTeam) error {
+	return c.makeRequest(ctx, "AddProjectTeams", http.MethodPost, fmt.Sprintf("/groups/%s/teams", projectID), teams, nil)
+}
+
+// UpdateProjectTeamRoles replaces the roles of a team in a project.
+func (c *client) UpdateProjectTeamRoles(ctx context.Context, projectID, teamID string, roles []string) error {
+	payload := map[string][]string{"roleNames": roles}
+	return c.makeRequest(ctx, "UpdateProjectTeamRoles", http.MethodPatch, fmt.Sprintf("/groups/%s/teams/%s", projectID, teamID), payload, nil)
+}
+
+// RemoveProjectTeam removes a team from a project.
+func (c *client) RemoveProjectTeam(ctx context.Context, projectID, teamID string) error {
+	return c.makeRequest(ctx, "RemoveProjectTeam", http.MethodDelete, fmt.Sprintf("/groups/%s/teams/%s", projectID, teamID), nil, nil)
+}
+
+// CloudProviderAccessRole is an AWS IAM role that Atlas assumes to access
+// resources in an AWS account.
+type CloudProviderAccessRole struct {
+	RoleID                     string     `json:"roleId,omitempty"`
+	ProviderName               string     `json:"providerName,omitempty"`
+	AtlasAWSAccountARN         string     `json:"atlasAWSAccountArn,omitempty"`
+	AtlasAssumedRoleExternalID string     `json:"atlasAssumedRoleExternalId,omitempty"`
+	IAMAssumedRoleARN          string     `json:"iamAssumedRoleArn,omitempty"`
+	AuthorizedDate             *time.Time `json:"authorizedDate,omitempty"`
+	CreatedDate                *time.Time `json:"createdDate,omitempty"`
+}
+
+// CloudProviderAWS is the provider name of AWS cloud provider access roles.
+const CloudProviderAWS = "AWS"
+
+// CreateCloudProviderAccessRole creates an unauthorized AWS cloud provider
+// access role in a project.
+func (c *client) CreateCloudProviderAccessRole(ctx context.Context, projectID string) (*CloudProviderAccessRole, error) {
+	role := &CloudProviderAccessRole{}
+	payload := CloudProviderAccessRole{ProviderName: CloudProviderAWS}
+	if err := c.makeRequest(ctx, "CreateCloudProviderAccessRole", http.MethodPost, fmt.Sprintf("/groups/%s/cloudProviderAccess", projectID), payload, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// GetCloudProviderAccessRole returns a cloud provider access role of a
+// project.
+func (c *client) GetCloudProviderAccessRole(ctx context.Context, projectID, roleID string) (*CloudProviderAccessRole, error) {
+	role := &CloudProviderAccessRole{}
+	if err := c.makeRequest(ctx, "GetCloudProviderAccessRole", http.MethodGet, fmt.Sprintf("/groups/%s/cloudProviderAccess/%s", projectID, roleID), nil, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// AuthorizeCloudProviderAccessRole authorizes Atlas to assume an IAM role.
+// Atlas checks that the trust policy of the IAM role allows it.
+func (c *client) AuthorizeCloudProviderAccessRole(ctx context.Context, projectID, roleID, iamRoleARN string) (*CloudProviderAccessRole, error) {
+	role := &CloudProviderAccessRole{}
+	payload := CloudProviderAccessRole{ProviderName: CloudProviderAWS, IAMAssumedRoleARN: iamRoleARN}
+	if err := c.makeRequest(ctx, "AuthorizeCloudProviderAccessRole", http.MethodPatch, fmt.Sprintf("/groups/%s/cloudProviderAccess/%s", projectID, roleID), payload, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// DeauthorizeCloudProviderAccessRole deauthorizes and removes a cloud
+// provider access role.
+func (c *client) DeauthorizeCloudProviderAccessRole(ctx context.Context, projectID, roleID string) error {
+	return c.makeRequest(ctx, "DeauthorizeCloudProviderAccessRole", http.MethodDelete, fmt.Sprintf("/groups/%s/cloudProviderAccess/%s/%s", projectID, CloudProviderAWS, roleID), nil, nil)
+}
+
+// AWSKMSConfiguration configures encryption at rest with an AWS KMS key.
+type AWSKMSConfiguration struct {
+	Enabled             *bool  `json:"enabled,omitempty"`
+	CustomerMasterKeyID string `json:"customerMasterKeyID,omitempty"`
+	Region              string `json:"region,omitempty"`
+	RoleID              string `json:"roleId,omitempty"`
+	Valid               *bool  `json:"valid,omitempty"`
+}
+
+// EncryptionAtRest is the encryption at rest configuration of a project.
+type EncryptionAtRest struct {
+	AWSKMS *AWSKMSConfiguration `json:"awsKms,omitempty"`
+}
+
+// GetEncryptionAtRest returns the encryption at rest configuration of a
+// project.
+func (c *client) GetEncryptionAtRest(ctx context.Context, projectID string) (*EncryptionAtRest, error) {
+	config := &EncryptionAtRest{}
+	if err := c.makeRequest(ctx, "GetEncryptionAtRest", http.MethodGet, fmt.Sprintf("/groups/%s/encryptionAtRest", projectID), nil, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// UpdateEncryptionAtRest changes the encryption at rest configuration of a
+// project.
+func (c *client) UpdateEncryptionAtRest(ctx context.Context, projectID string, config EncryptionAtRest) (*EncryptionAtRest, error) {
+	updated := &EncryptionAtRest{}
+	if err := c.makeRequest(ctx, "UpdateEncryptionAtRest", http.MethodPatch, fmt.Sprintf("/groups/%s/encryptionAtRest", projectID), config, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
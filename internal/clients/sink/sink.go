@@ -0,0 +1,53 @@
+// Package sink contains the stores organization API keys are written to.
+package sink
+
+import (
+	"context"
+
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/vault"
+)
+
+// A Sink stores the credentials of organization API keys.
+type Sink interface {
+	// Put writes credentials under a name and returns a reference to the
+	// written secret, e.g. its ARN.
+	Put(ctx context.Context, name string, creds awsclient.MongoDBAPICredentials) (string, error)
+
+	// Get reads the credentials stored under a name.
+	Get(ctx context.Context, name string) (*awsclient.MongoDBAPICredentials, error)
+
+	// Delete deletes the credentials stored under a name. Unless forced, they
+	// can be recovered for some time.
+	Delete(ctx context.Context, name string, force bool) error
+}
+
+var (
+	_ Sink = &SecretsManager{}
+	_ Sink = &vault.Client{}
+)
+
+// SecretsManager is a Sink that writes credentials to AWS Secrets Manager.
+type SecretsManager struct {
+	Client  awsclient.SecretStore
+	Format  awsclient.PayloadFormat
+	Options awsclient.SecretOptions
+
+	// RecoveryWindowInDays of deleted secrets.
+	RecoveryWindowInDays int64
+}
+
+// Put writes credentials to a secret and returns its ARN.
+func (s *SecretsManager) Put(ctx context.Context, name string, creds awsclient.MongoDBAPICredentials) (string, error) {
+	return s.Client.PutSecret(ctx, name, creds, s.Format, s.Options)
+}
+
+// Get reads credentials from a secret.
+func (s *SecretsManager) Get(ctx context.Context, name string) (*awsclient.MongoDBAPICredentials, error) {
+	return s.Client.GetSecretWithFormat(ctx, name, s.Format)
+}
+
+// Delete deletes a secret.
+func (s *SecretsManager) Delete(ctx context.Context, name string, force bool) error {
+	return s.Client.DeleteSecret(ctx, name, force, s.RecoveryWindowInDays)
+}
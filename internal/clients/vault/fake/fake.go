@@ -0,0 +1,93 @@
+// Package fake contains an in-memory Vault server for tests.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Server is a Vault server with the AppRole auth method and a KV v2 secrets
+// engine mounted at secret/.
+type Server struct {
+	mu sync.Mutex
+
+	// LeaseDuration and Renewable of the tokens returned by logins.
+	LeaseDuration int
+	Renewable     bool
+
+	// Logins and Renewals count the token requests.
+	Logins   int
+	Renewals int
+
+	// Data maps the paths of secrets to their data.
+	Data map[string]map[string]interface{}
+}
+
+// NewServer returns an empty Server whose tokens are valid for an hour.
+func NewServer() *Server {
+	return &Server{LeaseDuration: 3600, Data: map[string]map[string]interface{}{}}
+}
+
+// ServeHTTP serves the Vault API.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch path := r.URL.Path; {
+	case path == "/v1/auth/approle/login":
+		s.Logins++
+		s.writeAuth(w, fmt.Sprintf("token-%d", s.Logins))
+	case path == "/v1/auth/token/renew-self":
+		s.Renewals++
+		s.writeAuth(w, r.Header.Get("X-Vault-Token"))
+	case strings.HasPrefix(path, "/v1/secret/data/"):
+		s.serveData(w, r, strings.TrimPrefix(path, "/v1/secret/data/"))
+	case strings.HasPrefix(path, "/v1/secret/metadata/") && r.Method == http.MethodDelete:
+		delete(s.Data, strings.TrimPrefix(path, "/v1/secret/metadata/"))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *Server) serveData(w http.ResponseWriter, r *http.Request, key string) {
+	switch r.Method {
+	case http.MethodGet:
+		data, ok := s.Data[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]interface{}{"errors": []string{}})
+			return
+		}
+		writeJSON(w, map[string]interface{}{"data": map[string]interface{}{"data": data, "metadata": map[string]interface{}{"version": 1}}})
+	case http.MethodDelete:
+		delete(s.Data, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		body := struct {
+			Data map[string]interface{} `json:"data"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.Data[key] = body.Data
+		writeJSON(w, map[string]interface{}{"data": map[string]interface{}{"version": 1}})
+	}
+}
+
+func (s *Server) writeAuth(w http.ResponseWriter, token string) {
+	writeJSON(w, map[string]interface{}{"auth": map[string]interface{}{
+		"client_token":   token,
+		"lease_duration": s.LeaseDuration,
+		"renewable":      s.Renewable,
+	}})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,339 @@
+// Package vault writes MongoDB API credentials to a HashiCorp Vault KV v2
+// secrets engine.
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	apisv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/metrics"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNoVaultConfig   = "StoreConfig does not configure Vault"
+	errKVVersion       = "only the Vault KV v2 secrets engine is supported"
+	errNewClient       = "cannot create Vault client"
+	errCABundle        = "cannot get Vault CA bundle"
+	errToken           = "cannot get Vault token"
+	errSecretID        = "cannot get AppRole secret ID"
+	errServiceAccount  = "cannot read service account token"
+	errLogin           = "cannot log in to Vault"
+	errRenew           = "cannot renew Vault token"
+	errNoAuthConfig    = "Vault auth method is not configured"
+	errFmtUnknownAuth  = "unknown Vault auth method %q"
+	errPut             = "cannot write secret to Vault"
+	errGet             = "cannot read secret from Vault"
+	errDelete          = "cannot delete secret from Vault"
+	errMarshal         = "cannot marshal MongoDB credentials"
+	defaultAppRolePath = "approle"
+	defaultK8sPath     = "kubernetes"
+	defaultTokenPath   = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// Client reads and writes MongoDB API credentials in a KV v2 secrets engine.
+type Client struct {
+	kv     *vaultapi.KVv2
+	format awsclient.PayloadFormat
+}
+
+// renewBefore is how long before it expires a cached Vault token is renewed.
+const renewBefore = time.Minute
+
+// clients caches the Vault clients logged in with the auth method of a
+// StoreConfig, so that the provider does not log in on every reconcile.
+var clients = newClientCache()
+
+type cachedClient struct {
+	resourceVersion string
+	client          *vaultapi.Client
+	expires         time.Time
+	renewable       bool
+}
+
+// cacheEntry holds the cached client of a StoreConfig. Its lock is held while
+// logging in, so that a slow Vault server only blocks the reconciles using
+// the same StoreConfig.
+type cacheEntry struct {
+	mu     sync.Mutex
+	cached *cachedClient
+}
+
+// clientCache caches Vault clients by StoreConfig.
+type clientCache struct {
+	mu      sync.Mutex
+	entries map[types.UID]*cacheEntry
+}
+
+func newClientCache() *clientCache {
+	return &clientCache{entries: map[types.UID]*cacheEntry{}}
+}
+
+// entry returns the cache entry of a StoreConfig.
+func (cc *clientCache) entry(uid types.UID) *cacheEntry {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	e, ok := cc.entries[uid]
+	if !ok {
+		e = &cacheEntry{}
+		cc.entries[uid] = e
+	}
+	return e
+}
+
+// NewClient returns a client for the Vault server configured by a
+// StoreConfig. The token it logs in with is reused until it expires.
+func NewClient(ctx context.Context, kube client.Client, sc *apisv1alpha1.StoreConfig, format awsclient.PayloadFormat) (*Client, error) {
+	return newClient(ctx, kube, sc, format, clients, time.Now())
+}
+
+func newClient(ctx context.Context, kube client.Client, sc *apisv1alpha1.StoreConfig, format awsclient.PayloadFormat, cache *clientCache, now time.Time) (*Client, error) {
+	cfg := sc.Spec.Vault
+	if cfg == nil {
+		return nil, errors.New(errNoVaultConfig)
+	}
+	if cfg.Version != nil && *cfg.Version != xpv1.VaultKVVersionV2 {
+		return nil, errors.New(errKVVersion)
+	}
+	c, err := cache.client(ctx, kube, sc, now)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{kv: c.KVv2(cfg.MountPath), format: format}, nil
+}
+
+// client returns a Vault client logged in with the auth method of a
+// StoreConfig. A cached client is reused until shortly before its token
+// expires. Then the token is renewed, or a new one is requested if it cannot
+// be renewed. Static tokens are not cached, since they may be rotated.
+func (cc *clientCache) client(ctx context.Context, kube client.Client, sc *apisv1alpha1.StoreConfig, now time.Time) (*vaultapi.Client, error) {
+	e := cc.entry(sc.GetUID())
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if cached := e.cached; cached != nil && cached.resourceVersion == sc.GetResourceVersion() {
+		if now.Add(renewBefore).Before(cached.expires) {
+			return cached.client, nil
+		}
+		if cached.renewable {
+			if auth, err := renew(ctx, cached.client); err == nil {
+				cached.expires = now.Add(time.Duration(auth.LeaseDuration) * time.Second)
+				cached.renewable = auth.Renewable
+				return cached.client, nil
+			}
+		}
+	}
+	e.cached = nil
+
+	c, err := newVaultClient(ctx, kube, sc.Spec.Vault)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := login(ctx, kube, c, sc.Spec)
+	if err != nil {
+		return nil, err
+	}
+	c.SetToken(auth.ClientToken)
+	if auth.LeaseDuration > 0 {
+		e.cached = &cachedClient{
+			resourceVersion: sc.GetResourceVersion(),
+			client:          c,
+			expires:         now.Add(time.Duration(auth.LeaseDuration) * time.Second),
+			renewable:       auth.Renewable,
+		}
+	}
+	return c, nil
+}
+
+// newVaultClient returns an unauthenticated client for a Vault server.
+func newVaultClient(ctx context.Context, kube client.Client, cfg *xpv1.VaultSecretStoreConfig) (*vaultapi.Client, error) {
+	vc := vaultapi.DefaultConfig()
+	vc.Address = cfg.Server
+	if cfg.CABundle != nil {
+		ca, err := resource.CommonCredentialExtractor(ctx, cfg.CABundle.Source, kube, cfg.CABundle.CommonCredentialSelectors)
+		if err != nil {
+			return nil, errors.Wrap(err, errCABundle)
+		}
+		if err := vc.ConfigureTLS(&vaultapi.TLSConfig{CACertBytes: ca}); err != nil {
+			return nil, errors.Wrap(err, errNewClient)
+		}
+	}
+	c, err := vaultapi.NewClient(vc)
+	return c, errors.Wrap(err, errNewClient)
+}
+
+// renew renews the token of a client.
+func renew(ctx context.Context, c *vaultapi.Client) (*vaultapi.SecretAuth, error) {
+	var secret *vaultapi.Secret
+	err := track(ctx, "RenewToken", func(ctx context.Context) error {
+		var err error
+		secret, err = c.Auth().Token().RenewSelfWithContext(ctx, 0)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errRenew)
+	}
+	if secret == nil || secret.Auth == nil {
+		return nil, errors.New(errRenew)
+	}
+	return secret.Auth, nil
+}
+
+// login returns a Vault token using the auth method of a StoreConfig. Static
+// tokens are returned without a lease duration.
+func login(ctx context.Context, kube client.Client, c *vaultapi.Client, spec apisv1alpha1.StoreConfigSpec) (*vaultapi.SecretAuth, error) {
+	if spec.VaultAuth == nil {
+		t := spec.Vault.Auth.Token
+		if t == nil {
+			return nil, errors.New(errNoAuthConfig)
+		}
+		token, err := resource.CommonCredentialExtractor(ctx, t.Source, kube, t.CommonCredentialSelectors)
+		if err != nil {
+			return nil, errors.Wrap(err, errToken)
+		}
+		return &vaultapi.SecretAuth{ClientToken: string(token)}, nil
+	}
+
+	var path string
+	var data map[string]interface{}
+	switch auth := spec.VaultAuth; auth.Method {
+	case apisv1alpha1.VaultAuthMethodAppRole:
+		if auth.AppRole == nil {
+			return nil, errors.New(errNoAuthConfig)
+		}
+		secretID, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: &auth.AppRole.SecretIDSecretRef})
+		if err != nil {
+			return nil, errors.Wrap(err, errSecretID)
+		}
+		path = "auth/" + withDefault(auth.AppRole.MountPath, defaultAppRolePath) + "/login"
+		data = map[string]interface{}{"role_id": auth.AppRole.RoleID, "secret_id": string(secretID)}
+	case apisv1alpha1.VaultAuthMethodKubernetes:
+		if auth.Kubernetes == nil {
+			return nil, errors.New(errNoAuthConfig)
+		}
+		jwt, err := os.ReadFile(withDefault(auth.Kubernetes.TokenPath, defaultTokenPath))
+		if err != nil {
+			return nil, errors.Wrap(err, errServiceAccount)
+		}
+		path = "auth/" + withDefault(auth.Kubernetes.MountPath, defaultK8sPath) + "/login"
+		data = map[string]interface{}{"role": auth.Kubernetes.Role, "jwt": string(jwt)}
+	default:
+		return nil, errors.Errorf(errFmtUnknownAuth, auth.Method)
+	}
+
+	var secret *vaultapi.Secret
+	err := track(ctx, "Login", func(ctx context.Context) error {
+		var err error
+		secret, err = c.Logical().WriteWithContext(ctx, path, data)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errLogin)
+	}
+	if secret == nil || secret.Auth == nil {
+		return nil, errors.New(errLogin)
+	}
+	return secret.Auth, nil
+}
+
+// Put writes credentials to a path of the secrets engine and returns the path.
+func (c *Client) Put(ctx context.Context, path string, creds awsclient.MongoDBAPICredentials) (string, error) {
+	b, err := c.format.Marshal(creds)
+	if err != nil {
+		return "", errors.Wrap(err, errMarshal)
+	}
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return "", errors.Wrap(err, errMarshal)
+	}
+	err = track(ctx, "Put", func(ctx context.Context) error {
+		_, err := c.kv.Put(ctx, path, data)
+		return err
+	})
+	return path, errors.Wrap(err, errPut)
+}
+
+// Get reads credentials from a path of the secrets engine.
+func (c *Client) Get(ctx context.Context, path string) (*awsclient.MongoDBAPICredentials, error) {
+	var secret *vaultapi.KVSecret
+	err := track(ctx, "Get", func(ctx context.Context) error {
+		var err error
+		secret, err = c.kv.Get(ctx, path)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errGet)
+	}
+	b, err := json.Marshal(secret.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, errGet)
+	}
+	creds, err := c.format.Unmarshal(b)
+	return creds, errors.Wrap(err, errGet)
+}
+
+// Delete deletes the latest version of the credentials at a path, which can
+// be undeleted. Forced deletion permanently removes all versions.
+func (c *Client) Delete(ctx context.Context, path string, force bool) error {
+	err := track(ctx, "Delete", func(ctx context.Context) error {
+		if force {
+			return c.kv.DeleteMetadata(ctx, path)
+		}
+		return c.kv.Delete(ctx, path)
+	})
+	return errors.Wrap(err, errDelete)
+}
+
+// IsNotFound reports whether an error was caused by a secret that does not
+// exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, vaultapi.ErrSecretNotFound)
+}
+
+// track runs a Vault request inside a span and records its outcome.
+func track(ctx context.Context, operation string, fn func(ctx context.Context) error) error {
+	ctx, span := tracing.StartSpan(ctx, metrics.ServiceVault, operation)
+	start := time.Now()
+	err := fn(ctx)
+	code := http.StatusOK
+	errType := metrics.ErrorTypeNone
+	var respErr *vaultapi.ResponseError
+	switch {
+	case errors.As(err, &respErr):
+		code = respErr.StatusCode
+		errType = metrics.ErrorTypeOther
+		if code == http.StatusTooManyRequests || code >= http.StatusInternalServerError {
+			errType = metrics.ErrorTypeRetryable
+		}
+	case IsNotFound(err):
+		code = http.StatusNotFound
+		errType = metrics.ErrorTypeNotFound
+	case err != nil:
+		code = 0
+		errType = metrics.ErrorTypeRetryable
+	}
+	metrics.ObserveRequest(metrics.ServiceVault, operation, code, errType, time.Since(start))
+	tracing.EndSpan(span, code, err)
+	return err
+}
+
+func withDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
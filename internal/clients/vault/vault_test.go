@@ -0,0 +1,170 @@
+package vault
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/vault/fake"
+)
+
+// newStoreConfig returns a StoreConfig that logs in to a Vault server with
+// AppRole, and a Kubernetes client holding the secret ID of the AppRole.
+func newStoreConfig(t *testing.T, server string) (*apisv1alpha1.StoreConfig, *kubefake.ClientBuilder) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "approle"},
+		Data:       map[string][]byte{"secretId": []byte("secret-id")},
+	}
+	sc := &apisv1alpha1.StoreConfig{ObjectMeta: metav1.ObjectMeta{Name: "vault", UID: types.UID("uid"), ResourceVersion: "1"}}
+	sc.Spec.Vault = &xpv1.VaultSecretStoreConfig{Server: server, MountPath: "secret"}
+	sc.Spec.VaultAuth = &apisv1alpha1.VaultAuth{
+		Method: apisv1alpha1.VaultAuthMethodAppRole,
+		AppRole: &apisv1alpha1.VaultAppRoleAuth{
+			RoleID: "role-id",
+			SecretIDSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "approle"},
+				Key:             "secretId",
+			},
+		},
+	}
+	return sc, kubefake.NewClientBuilder().WithScheme(scheme).WithObjects(secret)
+}
+
+func TestClient(t *testing.T) {
+	server := httptest.NewServer(fake.NewServer())
+	defer server.Close()
+	sc, kube := newStoreConfig(t, server.URL)
+	ctx := context.Background()
+
+	c, err := newClient(ctx, kube.Build(), sc, awsclient.PayloadFormat{Fields: []string{awsclient.FieldOrgID}}, newClientCache(), time.Now())
+	if err != nil {
+		t.Fatalf("newClient() error = %v", err)
+	}
+
+	want := awsclient.MongoDBAPICredentials{PublicKey: "public", PrivateKey: "private", OrgID: "orgID123"}
+	if _, err := c.Put(ctx, "mongodb/test-org", want); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	got, err := c.Get(ctx, "mongodb/test-org")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(&want, got); diff != "" {
+		t.Errorf("Get() -want, +got:\n%s", diff)
+	}
+	if err := c.Delete(ctx, "mongodb/test-org", true); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := c.Get(ctx, "mongodb/test-org"); !IsNotFound(err) {
+		t.Errorf("Get() error = %v, want not found", err)
+	}
+}
+
+func TestClientCache(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name            string
+		renewable       bool
+		at              time.Time
+		resourceVersion string
+		wantLogins      int
+		wantRenewals    int
+	}{
+		{
+			name:         "Cached",
+			at:           now.Add(time.Minute),
+			wantLogins:   1,
+			wantRenewals: 0,
+		},
+		{
+			name:         "Renewed",
+			renewable:    true,
+			at:           now.Add(time.Hour - renewBefore/2),
+			wantLogins:   1,
+			wantRenewals: 1,
+		},
+		{
+			name:       "Expired",
+			at:         now.Add(time.Hour),
+			wantLogins: 2,
+		},
+		{
+			name:            "StoreConfigChanged",
+			at:              now.Add(time.Minute),
+			resourceVersion: "2",
+			wantLogins:      2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vault := fake.NewServer()
+			vault.Renewable = tt.renewable
+			server := httptest.NewServer(vault)
+			defer server.Close()
+			sc, kube := newStoreConfig(t, server.URL)
+			cache := newClientCache()
+			ctx := context.Background()
+
+			if _, err := newClient(ctx, kube.Build(), sc, awsclient.PayloadFormat{}, cache, now); err != nil {
+				t.Fatalf("newClient() error = %v", err)
+			}
+			if tt.resourceVersion != "" {
+				sc.SetResourceVersion(tt.resourceVersion)
+			}
+			if _, err := newClient(ctx, kube.Build(), sc, awsclient.PayloadFormat{}, cache, tt.at); err != nil {
+				t.Fatalf("newClient() error = %v", err)
+			}
+			if vault.Logins != tt.wantLogins {
+				t.Errorf("logins = %d, want %d", vault.Logins, tt.wantLogins)
+			}
+			if vault.Renewals != tt.wantRenewals {
+				t.Errorf("renewals = %d, want %d", vault.Renewals, tt.wantRenewals)
+			}
+		})
+	}
+}
+
+func TestClientCacheLocksPerStoreConfig(t *testing.T) {
+	server := httptest.NewServer(fake.NewServer())
+	defer server.Close()
+	sc, kube := newStoreConfig(t, server.URL)
+	cache := newClientCache()
+
+	// Another StoreConfig is logging in to a slow Vault server.
+	other := cache.entry(types.UID("other"))
+	other.mu.Lock()
+	defer other.mu.Unlock()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := newClient(context.Background(), kube.Build(), sc, awsclient.PayloadFormat{}, cache, time.Now())
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("newClient() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("newClient() is blocked by the login of another StoreConfig")
+	}
+}
@@ -0,0 +1,250 @@
+package backuprestorejob
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/shared"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotRestoreJob    = "managed resource is not a BackupRestoreJob custom resource"
+	errGetRestoreJob    = "cannot get restore job"
+	errCreateRestoreJob = "cannot create restore job"
+	errCancelRestoreJob = "cannot cancel restore job"
+	errRestoreJobGone   = "restore job no longer exists in Atlas"
+	errNoSnapshot       = "snapshotRef or snapshotID is required"
+	errNoPointInTime    = "pointInTimeUTCSeconds is required for pointInTime restores"
+	errNoTargetCluster  = "targetClusterName is required for automated and pointInTime restores"
+
+	errFmtGetSnapshotRef   = "cannot get BackupSnapshot %s"
+	errFmtSnapshotNotReady = "BackupSnapshot %s has not been created yet"
+)
+
+// Setup adds a controller that reconciles BackupRestoreJob managed
+// resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BackupRestoreJobGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BackupRestoreJobGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.BackupRestoreJob{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization that owns
+// the project of the cluster.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BackupRestoreJob)
+	if !ok {
+		return nil, errors.New(errNotRestoreJob)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	_, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: client, logger: c.logger}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client svc.Service
+	logger logging.Logger
+}
+
+// Observe reports the progress of the restore job. A restore job is never
+// restarted; it only stops existing while the resource is being deleted.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BackupRestoreJob)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRestoreJob)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p := cr.Spec.ForProvider
+	job, err := c.client.GetRestoreJob(ctx, p.ProjectID, p.ClusterName, id)
+	if svc.IsNotFoundError(err) {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cr.SetConditions(xpv1.Unavailable().WithMessage(errRestoreJobGone))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRestoreJob)
+	}
+
+	failed := job.Failed != nil && *job.Failed
+	cr.Status.AtProvider.ID = job.ID
+	cr.Status.AtProvider.SnapshotID = job.SnapshotID
+	cr.Status.AtProvider.Timestamp = shared.MetaTime(job.Timestamp)
+	cr.Status.AtProvider.FinishedAt = shared.MetaTime(job.FinishedAt)
+	cr.Status.AtProvider.Cancelled = job.Cancelled
+	cr.Status.AtProvider.Expired = job.Expired
+	cr.Status.AtProvider.Failed = failed
+	cr.Status.AtProvider.DeliveryURLs = job.DeliveryURL
+
+	finished := setConditions(cr, job)
+	if finished && meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BackupRestoreJob)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRestoreJob)
+	}
+	p := cr.Spec.ForProvider
+	job := svc.RestoreJob{
+		DeliveryType:          p.DeliveryType,
+		PointInTimeUTCSeconds: p.PointInTimeUTCSeconds,
+		TargetClusterName:     p.TargetClusterName,
+		TargetGroupID:         p.TargetProjectID,
+	}
+	if job.TargetGroupID == "" {
+		job.TargetGroupID = p.ProjectID
+	}
+
+	switch p.DeliveryType {
+	case v1alpha1.DeliveryTypePointInTime:
+		if p.PointInTimeUTCSeconds == nil {
+			return managed.ExternalCreation{}, errors.New(errNoPointInTime)
+		}
+	default:
+		id, err := c.snapshotID(ctx, p)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		job.SnapshotID = id
+	}
+	if p.DeliveryType == v1alpha1.DeliveryTypeDownload {
+		job.TargetClusterName, job.TargetGroupID = "", ""
+	} else if p.TargetClusterName == "" {
+		return managed.ExternalCreation{}, errors.New(errNoTargetCluster)
+	}
+
+	created, err := c.client.CreateRestoreJob(ctx, p.ProjectID, p.ClusterName, job)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRestoreJob)
+	}
+	meta.SetExternalName(cr, created.ID)
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update does nothing because a restore job cannot be changed once started.
+func (c *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete cancels the restore job if it has not finished yet.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BackupRestoreJob)
+	if !ok {
+		return errors.New(errNotRestoreJob)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.FinishedAt != nil || cr.Status.AtProvider.Cancelled || cr.Status.AtProvider.Expired || cr.Status.AtProvider.Failed {
+		return nil
+	}
+	p := cr.Spec.ForProvider
+	err := c.client.CancelRestoreJob(ctx, p.ProjectID, p.ClusterName, meta.GetExternalName(cr))
+	if err != nil && !svc.IsNotFoundError(err) {
+		return errors.Wrap(err, errCancelRestoreJob)
+	}
+	return nil
+}
+
+// snapshotID returns the ID of the snapshot to restore.
+func (c *external) snapshotID(ctx context.Context, p v1alpha1.BackupRestoreJobParameters) (string, error) {
+	if p.SnapshotID != "" {
+		return p.SnapshotID, nil
+	}
+	if p.SnapshotRef == nil {
+		return "", errors.New(errNoSnapshot)
+	}
+	snapshot := &v1alpha1.BackupSnapshot{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: p.SnapshotRef.Name}, snapshot); err != nil {
+		return "", errors.Wrapf(err, errFmtGetSnapshotRef, p.SnapshotRef.Name)
+	}
+	id := meta.GetExternalName(snapshot)
+	if id == "" {
+		return "", errors.Errorf(errFmtSnapshotNotReady, p.SnapshotRef.Name)
+	}
+	return id, nil
+}
+
+// setConditions reports the progress of a restore job and returns true if
+// the job finished.
+func setConditions(cr *v1alpha1.BackupRestoreJob, job *svc.RestoreJob) bool {
+	at := metav1.Now()
+	if job.FinishedAt != nil {
+		at = metav1.NewTime(*job.FinishedAt)
+	}
+	switch {
+	case job.Failed != nil && *job.Failed:
+		cr.SetConditions(xpv1.Unavailable(), v1alpha1.RestoreFailed(at, v1alpha1.ReasonRestoreFailed))
+	case job.Cancelled:
+		cr.SetConditions(xpv1.Unavailable(), v1alpha1.RestoreFailed(at, v1alpha1.ReasonRestoreCancelled))
+	case job.Expired:
+		cr.SetConditions(xpv1.Unavailable(), v1alpha1.RestoreFailed(at, v1alpha1.ReasonRestoreExpired))
+	case job.FinishedAt != nil:
+		cr.SetConditions(xpv1.Available(), v1alpha1.RestoreComplete(at))
+	default:
+		cr.SetConditions(xpv1.Creating())
+		return false
+	}
+	return true
+}
@@ -0,0 +1,293 @@
+package backuprestorejob
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	kubefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// mockService serves a single restore job.
+type mockService struct {
+	svc.Service
+	job       *svc.RestoreJob
+	created   *svc.RestoreJob
+	cancelled bool
+}
+
+func (m *mockService) GetRestoreJob(_ context.Context, _, _, _ string) (*svc.RestoreJob, error) {
+	if m.job == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.job, nil
+}
+
+func (m *mockService) CreateRestoreJob(_ context.Context, _, _ string, job svc.RestoreJob) (*svc.RestoreJob, error) {
+	m.created = &job
+	return &svc.RestoreJob{ID: "job-id"}, nil
+}
+
+func (m *mockService) CancelRestoreJob(_ context.Context, _, _, _ string) error {
+	m.cancelled = true
+	return nil
+}
+
+func TestSetConditions(t *testing.T) {
+	finishedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		job      svc.RestoreJob
+		finished bool
+		ready    corev1.ConditionStatus
+		complete bool
+		reason   xpv1.ConditionReason
+	}{
+		{
+			name:  "InProgress",
+			job:   svc.RestoreJob{},
+			ready: corev1.ConditionFalse,
+		},
+		{
+			name:     "Finished",
+			job:      svc.RestoreJob{FinishedAt: &finishedAt},
+			finished: true,
+			ready:    corev1.ConditionTrue,
+			complete: true,
+		},
+		{
+			name:     "Failed",
+			job:      svc.RestoreJob{FinishedAt: &finishedAt, Failed: pointer.Bool(true)},
+			finished: true,
+			ready:    corev1.ConditionFalse,
+			reason:   v1alpha1.ReasonRestoreFailed,
+		},
+		{
+			name:     "Cancelled",
+			job:      svc.RestoreJob{Cancelled: true},
+			finished: true,
+			ready:    corev1.ConditionFalse,
+			reason:   v1alpha1.ReasonRestoreCancelled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.BackupRestoreJob{}
+			if got := setConditions(cr, &tt.job); got != tt.finished {
+				t.Errorf("setConditions(...) = %t, want %t", got, tt.finished)
+			}
+			if got := cr.GetCondition(xpv1.TypeReady).Status; got != tt.ready {
+				t.Errorf("Ready = %s, want %s", got, tt.ready)
+			}
+			complete := cr.GetCondition(v1alpha1.TypeComplete)
+			if (complete.Status == corev1.ConditionTrue) != tt.complete {
+				t.Errorf("Complete = %s, want %t", complete.Status, tt.complete)
+			}
+			if tt.complete && !complete.LastTransitionTime.Time.Equal(finishedAt) {
+				t.Errorf("Complete.LastTransitionTime = %s, want %s", complete.LastTransitionTime, finishedAt)
+			}
+			if failed := cr.GetCondition(v1alpha1.TypeFailed); failed.Reason != tt.reason {
+				t.Errorf("Failed.Reason = %q, want %q", failed.Reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	finishedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	deleted := metav1.NewTime(finishedAt)
+
+	tests := []struct {
+		name        string
+		deletion    *metav1.Time
+		job         *svc.RestoreJob
+		wantExists  bool
+		wantReady   corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name:       "InProgress",
+			job:        &svc.RestoreJob{ID: "job-id"},
+			wantExists: true,
+			wantReady:  corev1.ConditionFalse,
+		},
+		{
+			name:       "Finished",
+			job:        &svc.RestoreJob{ID: "job-id", FinishedAt: &finishedAt},
+			wantExists: true,
+			wantReady:  corev1.ConditionTrue,
+		},
+		{
+			name:     "FinishedWhileDeleting",
+			deletion: &deleted,
+			job:      &svc.RestoreJob{ID: "job-id", FinishedAt: &finishedAt},
+		},
+		{
+			// A restore job that is gone must not be started again.
+			name:        "Gone",
+			wantExists:  true,
+			wantReady:   corev1.ConditionFalse,
+			wantMessage: errRestoreJobGone,
+		},
+		{
+			name:     "GoneWhileDeleting",
+			deletion: &deleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.BackupRestoreJob{ObjectMeta: metav1.ObjectMeta{Name: "restore", DeletionTimestamp: tt.deletion}}
+			meta.SetExternalName(cr, "job-id")
+			e := &external{client: &mockService{job: tt.job}, logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if !tt.wantExists {
+				return
+			}
+			ready := cr.GetCondition(xpv1.TypeReady)
+			if ready.Status != tt.wantReady || ready.Message != tt.wantMessage {
+				t.Errorf("Observe() Ready = %s %q, want %s %q", ready.Status, ready.Message, tt.wantReady, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	snapshot := &v1alpha1.BackupSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "snapshot"}}
+	meta.SetExternalName(snapshot, "snapshot-id")
+	pending := &v1alpha1.BackupSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "pending"}}
+	kube := kubefake.NewClientBuilder().WithScheme(scheme).WithObjects(snapshot, pending).Build()
+
+	tests := []struct {
+		name    string
+		params  v1alpha1.BackupRestoreJobParameters
+		want    *svc.RestoreJob
+		wantErr bool
+	}{
+		{
+			name: "SnapshotRef",
+			params: v1alpha1.BackupRestoreJobParameters{
+				ProjectID:         "project",
+				DeliveryType:      v1alpha1.DeliveryTypeAutomated,
+				SnapshotRef:       &xpv1.Reference{Name: "snapshot"},
+				TargetClusterName: "target",
+			},
+			want: &svc.RestoreJob{
+				DeliveryType:      v1alpha1.DeliveryTypeAutomated,
+				SnapshotID:        "snapshot-id",
+				TargetClusterName: "target",
+				TargetGroupID:     "project",
+			},
+		},
+		{
+			name: "Download",
+			params: v1alpha1.BackupRestoreJobParameters{
+				ProjectID:         "project",
+				DeliveryType:      v1alpha1.DeliveryTypeDownload,
+				SnapshotID:        "snapshot-id",
+				TargetClusterName: "ignored",
+			},
+			want: &svc.RestoreJob{
+				DeliveryType: v1alpha1.DeliveryTypeDownload,
+				SnapshotID:   "snapshot-id",
+			},
+		},
+		{
+			name: "SnapshotNotReady",
+			params: v1alpha1.BackupRestoreJobParameters{
+				DeliveryType:      v1alpha1.DeliveryTypeAutomated,
+				SnapshotRef:       &xpv1.Reference{Name: "pending"},
+				TargetClusterName: "target",
+			},
+			wantErr: true,
+		},
+		{
+			name: "PointInTimeWithoutTime",
+			params: v1alpha1.BackupRestoreJobParameters{
+				DeliveryType:      v1alpha1.DeliveryTypePointInTime,
+				TargetClusterName: "target",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.BackupRestoreJob{ObjectMeta: metav1.ObjectMeta{Name: "restore"}}
+			cr.Spec.ForProvider = tt.params
+			m := &mockService{}
+			e := &external{kube: kube, client: m, logger: logging.NewNopLogger()}
+
+			_, err := e.Create(context.Background(), cr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, m.created); diff != "" {
+				t.Errorf("Create() -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	finishedAt := metav1.Now()
+
+	tests := []struct {
+		name       string
+		status     v1alpha1.BackupRestoreJobObservation
+		wantCancel bool
+	}{
+		{
+			name:       "InProgress",
+			wantCancel: true,
+		},
+		{
+			name:   "Finished",
+			status: v1alpha1.BackupRestoreJobObservation{FinishedAt: &finishedAt},
+		},
+		{
+			name:   "Failed",
+			status: v1alpha1.BackupRestoreJobObservation{Failed: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.BackupRestoreJob{ObjectMeta: metav1.ObjectMeta{Name: "restore"}}
+			meta.SetExternalName(cr, "job-id")
+			cr.Status.AtProvider = tt.status
+			m := &mockService{}
+
+			if err := (&external{client: m, logger: logging.NewNopLogger()}).Delete(context.Background(), cr); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+			if m.cancelled != tt.wantCancel {
+				t.Errorf("Delete() cancelled = %v, want %v", m.cancelled, tt.wantCancel)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package backupsnapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/shared"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotSnapshot    = "managed resource is not a BackupSnapshot custom resource"
+	errGetSnapshot    = "cannot get snapshot"
+	errCreateSnapshot = "cannot create snapshot"
+	errUpdateSnapshot = "cannot update retention of snapshot"
+	errDeleteSnapshot = "cannot delete snapshot"
+	errSnapshotGone   = "snapshot expired or was deleted in Atlas"
+)
+
+// retentionTolerance is how far the expiry of a snapshot may be off from its
+// retention before it is updated.
+const retentionTolerance = time.Hour
+
+// Setup adds a controller that reconciles BackupSnapshot managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BackupSnapshotGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BackupSnapshotGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.BackupSnapshot{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization that owns
+// the project of the cluster.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BackupSnapshot)
+	if !ok {
+		return nil, errors.New(errNotSnapshot)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	_, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: client, logger: c.logger}, nil
+}
+
+type external struct {
+	client svc.Service
+	logger logging.Logger
+}
+
+// Observe reports the status of the snapshot. A snapshot that expired or was
+// deleted outside Crossplane is not taken again; it only stops existing while
+// the resource is being deleted.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BackupSnapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSnapshot)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p := cr.Spec.ForProvider
+	snapshot, err := c.client.GetSnapshot(ctx, p.ProjectID, p.ClusterName, id)
+	if svc.IsNotFoundError(err) {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cr.SetConditions(xpv1.Unavailable().WithMessage(errSnapshotGone))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSnapshot)
+	}
+
+	cr.Status.AtProvider.ID = snapshot.ID
+	cr.Status.AtProvider.Status = snapshot.Status
+	cr.Status.AtProvider.SnapshotType = snapshot.SnapshotType
+	cr.Status.AtProvider.CreatedAt = shared.MetaTime(snapshot.CreatedAt)
+	cr.Status.AtProvider.ExpiresAt = shared.MetaTime(snapshot.ExpiresAt)
+	cr.Status.AtProvider.StorageSizeBytes = snapshot.StorageSizeBytes
+	cr.Status.AtProvider.MongodVersion = snapshot.MongodVersion
+
+	switch snapshot.Status {
+	case svc.SnapshotStatusCompleted:
+		cr.SetConditions(xpv1.Available())
+	case svc.SnapshotStatusQueued, svc.SnapshotStatusInProgress:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(snapshot.Status))
+	}
+
+	// The retention of a snapshot can only be changed once it completed.
+	upToDate := snapshot.Status != svc.SnapshotStatusCompleted || retentionUpToDate(p.RetentionInDays, snapshot)
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BackupSnapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSnapshot)
+	}
+	p := cr.Spec.ForProvider
+	snapshot, err := c.client.CreateSnapshot(ctx, p.ProjectID, p.ClusterName, svc.Snapshot{
+		Description:     p.Description,
+		RetentionInDays: p.RetentionInDays,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSnapshot)
+	}
+	meta.SetExternalName(cr, snapshot.ID)
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update changes the retention of the snapshot. The description of a
+// snapshot cannot be changed.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BackupSnapshot)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSnapshot)
+	}
+	p := cr.Spec.ForProvider
+	if err := c.client.UpdateSnapshotRetention(ctx, p.ProjectID, p.ClusterName, meta.GetExternalName(cr), p.RetentionInDays); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSnapshot)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BackupSnapshot)
+	if !ok {
+		return errors.New(errNotSnapshot)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	p := cr.Spec.ForProvider
+	err := c.client.DeleteSnapshot(ctx, p.ProjectID, p.ClusterName, meta.GetExternalName(cr))
+	if err != nil && !svc.IsNotFoundError(err) {
+		return errors.Wrap(err, errDeleteSnapshot)
+	}
+	return nil
+}
+
+// retentionUpToDate returns true if the snapshot expires retentionInDays
+// after its creation.
+func retentionUpToDate(retentionInDays int, snapshot *svc.Snapshot) bool {
+	if snapshot.CreatedAt == nil || snapshot.ExpiresAt == nil {
+		return true
+	}
+	want := snapshot.CreatedAt.Add(time.Duration(retentionInDays) * 24 * time.Hour)
+	diff := snapshot.ExpiresAt.Sub(want)
+	return diff > -retentionTolerance && diff < retentionTolerance
+}
@@ -0,0 +1,132 @@
+package backupsnapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// mockService serves a single snapshot.
+type mockService struct {
+	svc.Service
+	snapshot *svc.Snapshot
+	created  *svc.Snapshot
+}
+
+func (m *mockService) GetSnapshot(_ context.Context, _, _, _ string) (*svc.Snapshot, error) {
+	if m.snapshot == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.snapshot, nil
+}
+
+func (m *mockService) CreateSnapshot(_ context.Context, _, _ string, snapshot svc.Snapshot) (*svc.Snapshot, error) {
+	m.created = &snapshot
+	return &svc.Snapshot{ID: "snapshot-id", Status: svc.SnapshotStatusQueued}, nil
+}
+
+func TestObserve(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(7 * 24 * time.Hour)
+	deleted := metav1.NewTime(createdAt)
+
+	tests := []struct {
+		name         string
+		externalName string
+		deletion     *metav1.Time
+		snapshot     *svc.Snapshot
+		wantExists   bool
+		wantUpToDate bool
+		wantReady    corev1.ConditionStatus
+		wantMessage  string
+	}{
+		{
+			name: "NotCreated",
+		},
+		{
+			name:         "Completed",
+			externalName: "snapshot-id",
+			snapshot:     &svc.Snapshot{ID: "snapshot-id", Status: svc.SnapshotStatusCompleted, CreatedAt: &createdAt, ExpiresAt: &expiresAt},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantReady:    corev1.ConditionTrue,
+		},
+		{
+			name:         "RetentionChanged",
+			externalName: "snapshot-id",
+			snapshot:     &svc.Snapshot{ID: "snapshot-id", Status: svc.SnapshotStatusCompleted, CreatedAt: &createdAt, ExpiresAt: &createdAt},
+			wantExists:   true,
+			wantUpToDate: false,
+			wantReady:    corev1.ConditionTrue,
+		},
+		{
+			// An expired snapshot must not be taken again.
+			name:         "Expired",
+			externalName: "snapshot-id",
+			wantExists:   true,
+			wantUpToDate: true,
+			wantReady:    corev1.ConditionFalse,
+			wantMessage:  errSnapshotGone,
+		},
+		{
+			name:         "ExpiredWhileDeleting",
+			externalName: "snapshot-id",
+			deletion:     &deleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.BackupSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "snapshot", DeletionTimestamp: tt.deletion}}
+			cr.Spec.ForProvider.RetentionInDays = 7
+			meta.SetExternalName(cr, tt.externalName)
+			e := &external{client: &mockService{snapshot: tt.snapshot}, logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if !tt.wantExists {
+				return
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+			ready := cr.GetCondition(xpv1.TypeReady)
+			if ready.Status != tt.wantReady || ready.Message != tt.wantMessage {
+				t.Errorf("Observe() Ready = %s %q, want %s %q", ready.Status, ready.Message, tt.wantReady, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cr := &v1alpha1.BackupSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "snapshot"}}
+	cr.Spec.ForProvider.Description = "before upgrade"
+	cr.Spec.ForProvider.RetentionInDays = 7
+	m := &mockService{}
+
+	if _, err := (&external{client: m, logger: logging.NewNopLogger()}).Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if diff := cmp.Diff(&svc.Snapshot{Description: "before upgrade", RetentionInDays: 7}, m.created); diff != "" {
+		t.Errorf("Create() -want, +got:\n%s", diff)
+	}
+	if got := meta.GetExternalName(cr); got != "snapshot-id" {
+		t.Errorf("Create() external name = %q, want %q", got, "snapshot-id")
+	}
+}
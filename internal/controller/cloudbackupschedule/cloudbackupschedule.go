@@ -0,0 +1,313 @@
+package cloudbackupschedule
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotSchedule    = "managed resource is not a CloudBackupSchedule custom resource"
+	errGetSchedule    = "cannot get backup schedule"
+	errUpdateSchedule = "cannot update backup schedule"
+	errDeleteSchedule = "cannot delete backup schedule"
+)
+
+// Policy item frequency types.
+const (
+	frequencyHourly  = "hourly"
+	frequencyDaily   = "daily"
+	frequencyWeekly  = "weekly"
+	frequencyMonthly = "monthly"
+)
+
+// Setup adds a controller that reconciles CloudBackupSchedule managed
+// resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CloudBackupScheduleGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CloudBackupScheduleGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.CloudBackupSchedule{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization that owns
+// the project of the cluster.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CloudBackupSchedule)
+	if !ok {
+		return nil, errors.New(errNotSchedule)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	_, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: client, logger: c.logger}, nil
+}
+
+type external struct {
+	client svc.Service
+	logger logging.Logger
+}
+
+// Observe compares every setting of the schedule with the spec. Every cluster
+// with cloud backups has a schedule; the external name is the name of the
+// cluster once the spec was applied.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CloudBackupSchedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSchedule)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	schedule, err := c.client.GetBackupSchedule(ctx, cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.ClusterName)
+	if svc.IsNotFoundError(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSchedule)
+	}
+
+	cr.Status.AtProvider.ClusterID = schedule.ClusterID
+	cr.Status.AtProvider.PolicyID = policyID(schedule)
+	cr.Status.AtProvider.NextSnapshot = nil
+	if schedule.NextSnapshot != nil {
+		t := metav1.NewTime(*schedule.NextSnapshot)
+		cr.Status.AtProvider.NextSnapshot = &t
+	}
+	cr.SetConditions(xpv1.Available())
+
+	upToDate := isUpToDate(desired(cr.Spec.ForProvider, ""), schedule)
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+// Create applies the spec to the existing schedule of the cluster.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CloudBackupSchedule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSchedule)
+	}
+	if err := c.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.ClusterName)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CloudBackupSchedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSchedule)
+	}
+	return managed.ExternalUpdate{}, c.apply(ctx, cr)
+}
+
+// Delete removes all policy items of the cluster.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CloudBackupSchedule)
+	if !ok {
+		return errors.New(errNotSchedule)
+	}
+	err := c.client.DeleteBackupSchedule(ctx, cr.Spec.ForProvider.ProjectID, cr.Spec.ForProvider.ClusterName)
+	if err != nil && !svc.IsNotFoundError(err) {
+		return errors.Wrap(err, errDeleteSchedule)
+	}
+	return nil
+}
+
+// apply replaces the schedule of the cluster with the spec. Atlas identifies
+// the policy to replace the items of by its ID.
+func (c *external) apply(ctx context.Context, cr *v1alpha1.CloudBackupSchedule) error {
+	p := cr.Spec.ForProvider
+	schedule, err := c.client.GetBackupSchedule(ctx, p.ProjectID, p.ClusterName)
+	if err != nil {
+		return errors.Wrap(err, errGetSchedule)
+	}
+	if _, err := c.client.UpdateBackupSchedule(ctx, p.ProjectID, p.ClusterName, desired(p, policyID(schedule))); err != nil {
+		return errors.Wrap(err, errUpdateSchedule)
+	}
+	return nil
+}
+
+// policyID returns the ID of the policy of a schedule.
+func policyID(schedule *svc.BackupSchedule) string {
+	if len(schedule.Policies) == 0 {
+		return ""
+	}
+	return schedule.Policies[0].ID
+}
+
+// desired returns the schedule described by the spec.
+func desired(p v1alpha1.CloudBackupScheduleParameters, policyID string) svc.BackupSchedule {
+	items := make([]svc.BackupPolicyItem, 0, len(p.Hourly)+len(p.Daily)+len(p.Weekly)+len(p.Monthly))
+	for frequency, specItems := range map[string][]v1alpha1.BackupPolicyItem{
+		frequencyHourly:  p.Hourly,
+		frequencyDaily:   p.Daily,
+		frequencyWeekly:  p.Weekly,
+		frequencyMonthly: p.Monthly,
+	} {
+		for _, i := range specItems {
+			items = append(items, svc.BackupPolicyItem{
+				FrequencyType:     frequency,
+				FrequencyInterval: i.FrequencyInterval,
+				RetentionUnit:     i.RetentionUnit,
+				RetentionValue:    i.RetentionValue,
+			})
+		}
+	}
+
+	copySettings := make([]svc.BackupCopySetting, 0, len(p.CopySettings))
+	for _, s := range p.CopySettings {
+		copySettings = append(copySettings, svc.BackupCopySetting{
+			CloudProvider:     s.CloudProvider,
+			RegionName:        s.RegionName,
+			ReplicationSpecID: s.ReplicationSpecID,
+			ShouldCopyOplogs:  s.ShouldCopyOplogs,
+			Frequencies:       s.Frequencies,
+		})
+	}
+
+	schedule := svc.BackupSchedule{
+		AutoExportEnabled:                 pointer.Bool(p.Export != nil),
+		CopySettings:                      copySettings,
+		Policies:                          []svc.BackupPolicy{{ID: policyID, PolicyItems: normalizeItems(items)}},
+		ReferenceHourOfDay:                p.ReferenceHourOfDay,
+		ReferenceMinuteOfHour:             p.ReferenceMinuteOfHour,
+		RestoreWindowDays:                 p.RestoreWindowDays,
+		UseOrgAndGroupNamesInExportPrefix: pointer.Bool(p.UseOrgAndGroupNamesInExportPrefix),
+	}
+	if p.Export != nil {
+		schedule.Export = &svc.BackupExport{ExportBucketID: p.Export.ExportBucketID, FrequencyType: p.Export.FrequencyType}
+	}
+	return schedule
+}
+
+// isUpToDate returns true if the observed schedule matches the desired one.
+// Optional settings that are not in the spec are not compared.
+func isUpToDate(want svc.BackupSchedule, got *svc.BackupSchedule) bool {
+	for _, v := range []struct{ want, got *int }{
+		{want.ReferenceHourOfDay, got.ReferenceHourOfDay},
+		{want.ReferenceMinuteOfHour, got.ReferenceMinuteOfHour},
+		{want.RestoreWindowDays, got.RestoreWindowDays},
+	} {
+		if v.want != nil && (v.got == nil || *v.want != *v.got) {
+			return false
+		}
+	}
+
+	var items []svc.BackupPolicyItem
+	for _, p := range got.Policies {
+		items = append(items, p.PolicyItems...)
+	}
+	if !reflect.DeepEqual(want.Policies[0].PolicyItems, normalizeItems(items)) {
+		return false
+	}
+	if !reflect.DeepEqual(normalizeCopySettings(want.CopySettings), normalizeCopySettings(got.CopySettings)) {
+		return false
+	}
+
+	if pointer.BoolDeref(want.AutoExportEnabled, false) != pointer.BoolDeref(got.AutoExportEnabled, false) {
+		return false
+	}
+	if want.Export != nil && (got.Export == nil || *want.Export != *got.Export) {
+		return false
+	}
+	return pointer.BoolDeref(want.UseOrgAndGroupNamesInExportPrefix, false) == pointer.BoolDeref(got.UseOrgAndGroupNamesInExportPrefix, false)
+}
+
+// normalizeItems returns the policy items without their IDs in a stable
+// order.
+func normalizeItems(items []svc.BackupPolicyItem) []svc.BackupPolicyItem {
+	out := make([]svc.BackupPolicyItem, 0, len(items))
+	for _, i := range items {
+		i.ID = ""
+		out = append(out, i)
+	}
+	sort.Slice(out, func(a, b int) bool {
+		x, y := out[a], out[b]
+		if x.FrequencyType != y.FrequencyType {
+			return x.FrequencyType < y.FrequencyType
+		}
+		if x.FrequencyInterval != y.FrequencyInterval {
+			return x.FrequencyInterval < y.FrequencyInterval
+		}
+		if x.RetentionUnit != y.RetentionUnit {
+			return x.RetentionUnit < y.RetentionUnit
+		}
+		return x.RetentionValue < y.RetentionValue
+	})
+	return out
+}
+
+// normalizeCopySettings returns the copy settings in a stable order.
+func normalizeCopySettings(settings []svc.BackupCopySetting) []svc.BackupCopySetting {
+	out := make([]svc.BackupCopySetting, 0, len(settings))
+	for _, s := range settings {
+		s.Frequencies = append([]string{}, s.Frequencies...)
+		sort.Strings(s.Frequencies)
+		out = append(out, s)
+	}
+	sort.Slice(out, func(a, b int) bool {
+		x, y := out[a], out[b]
+		if x.ReplicationSpecID != y.ReplicationSpecID {
+			return x.ReplicationSpecID < y.ReplicationSpecID
+		}
+		if x.CloudProvider != y.CloudProvider {
+			return x.CloudProvider < y.CloudProvider
+		}
+		return x.RegionName < y.RegionName
+	})
+	return out
+}
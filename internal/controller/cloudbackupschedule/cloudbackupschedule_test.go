@@ -0,0 +1,218 @@
+package cloudbackupschedule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// mockService serves the backup schedule of a single cluster.
+type mockService struct {
+	svc.Service
+	schedule *svc.BackupSchedule
+	updated  *svc.BackupSchedule
+	deleted  bool
+}
+
+func (m *mockService) GetBackupSchedule(_ context.Context, _, _ string) (*svc.BackupSchedule, error) {
+	if m.schedule == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.schedule, nil
+}
+
+func (m *mockService) UpdateBackupSchedule(_ context.Context, _, _ string, schedule svc.BackupSchedule) (*svc.BackupSchedule, error) {
+	m.updated = &schedule
+	return &schedule, nil
+}
+
+func (m *mockService) DeleteBackupSchedule(_ context.Context, _, _ string) error {
+	m.deleted = true
+	return nil
+}
+
+// testParams returns a schedule with a daily snapshot kept for a week.
+func testParams() v1alpha1.CloudBackupScheduleParameters {
+	return v1alpha1.CloudBackupScheduleParameters{
+		ProjectID:   "project",
+		ClusterName: "cluster",
+		Daily:       []v1alpha1.BackupPolicyItem{{FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 7}},
+	}
+}
+
+// testSchedule returns the schedule of testParams as observed in Atlas.
+func testSchedule(retention int) *svc.BackupSchedule {
+	return &svc.BackupSchedule{
+		ClusterID: "cluster-id",
+		Policies: []svc.BackupPolicy{{ID: "p1", PolicyItems: []svc.BackupPolicyItem{
+			{ID: "i1", FrequencyType: "daily", FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: retention},
+		}}},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	params := v1alpha1.CloudBackupScheduleParameters{
+		ReferenceHourOfDay: pointer.Int(3),
+		Hourly:             []v1alpha1.BackupPolicyItem{{FrequencyInterval: 6, RetentionUnit: "days", RetentionValue: 2}},
+		Daily:              []v1alpha1.BackupPolicyItem{{FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 7}},
+		CopySettings: []v1alpha1.BackupCopySetting{{
+			CloudProvider: "AWS", RegionName: "EU_WEST_1", ReplicationSpecID: "r1", Frequencies: []string{"DAILY", "HOURLY"},
+		}},
+	}
+	observed := func() *svc.BackupSchedule {
+		return &svc.BackupSchedule{
+			AutoExportEnabled:     pointer.Bool(false),
+			ReferenceHourOfDay:    pointer.Int(3),
+			ReferenceMinuteOfHour: pointer.Int(17),
+			Policies: []svc.BackupPolicy{{ID: "p1", PolicyItems: []svc.BackupPolicyItem{
+				{ID: "i2", FrequencyType: "daily", FrequencyInterval: 1, RetentionUnit: "days", RetentionValue: 7},
+				{ID: "i1", FrequencyType: "hourly", FrequencyInterval: 6, RetentionUnit: "days", RetentionValue: 2},
+			}}},
+			CopySettings: []svc.BackupCopySetting{{
+				CloudProvider: "AWS", RegionName: "EU_WEST_1", ReplicationSpecID: "r1", Frequencies: []string{"HOURLY", "DAILY"},
+			}},
+		}
+	}
+
+	tests := []struct {
+		name string
+		got  func(*svc.BackupSchedule)
+		want bool
+	}{
+		{
+			name: "InSync",
+			got:  func(*svc.BackupSchedule) {},
+			want: true,
+		},
+		{
+			name: "ReferenceHourChanged",
+			got:  func(s *svc.BackupSchedule) { s.ReferenceHourOfDay = pointer.Int(4) },
+			want: false,
+		},
+		{
+			name: "RetentionChanged",
+			got:  func(s *svc.BackupSchedule) { s.Policies[0].PolicyItems[0].RetentionValue = 14 },
+			want: false,
+		},
+		{
+			name: "PolicyItemAdded",
+			got: func(s *svc.BackupSchedule) {
+				s.Policies[0].PolicyItems = append(s.Policies[0].PolicyItems, svc.BackupPolicyItem{ID: "i3", FrequencyType: "weekly", FrequencyInterval: 6, RetentionUnit: "weeks", RetentionValue: 4})
+			},
+			want: false,
+		},
+		{
+			name: "CopySettingRemoved",
+			got:  func(s *svc.BackupSchedule) { s.CopySettings = nil },
+			want: false,
+		},
+		{
+			name: "ExportEnabled",
+			got:  func(s *svc.BackupSchedule) { s.AutoExportEnabled = pointer.Bool(true) },
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := observed()
+			tt.got(got)
+			if isUpToDate(desired(params, "p1"), got) != tt.want {
+				t.Errorf("isUpToDate(...) = %t, want %t", !tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	tests := []struct {
+		name         string
+		externalName string
+		schedule     *svc.BackupSchedule
+		wantExists   bool
+		wantUpToDate bool
+	}{
+		{
+			name:     "NotApplied",
+			schedule: testSchedule(7),
+		},
+		{
+			name:         "InSync",
+			externalName: "cluster",
+			schedule:     testSchedule(7),
+			wantExists:   true,
+			wantUpToDate: true,
+		},
+		{
+			name:         "RetentionChangedOutsideCrossplane",
+			externalName: "cluster",
+			schedule:     testSchedule(14),
+			wantExists:   true,
+		},
+		{
+			name:         "ClusterDeleted",
+			externalName: "cluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.CloudBackupSchedule{ObjectMeta: metav1.ObjectMeta{Name: "schedule"}}
+			meta.SetExternalName(cr, tt.externalName)
+			cr.Spec.ForProvider = testParams()
+			e := &external{client: &mockService{schedule: tt.schedule}, logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+			if tt.wantExists && cr.Status.AtProvider.PolicyID != "p1" {
+				t.Errorf("Observe() PolicyID = %q, want %q", cr.Status.AtProvider.PolicyID, "p1")
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cr := &v1alpha1.CloudBackupSchedule{ObjectMeta: metav1.ObjectMeta{Name: "schedule"}}
+	cr.Spec.ForProvider = testParams()
+	m := &mockService{schedule: testSchedule(14)}
+
+	if _, err := (&external{client: m, logger: logging.NewNopLogger()}).Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	want := desired(testParams(), "p1")
+	if diff := cmp.Diff(&want, m.updated); diff != "" {
+		t.Errorf("Create() -want, +got:\n%s", diff)
+	}
+	if got := meta.GetExternalName(cr); got != "cluster" {
+		t.Errorf("Create() external name = %q, want %q", got, "cluster")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cr := &v1alpha1.CloudBackupSchedule{ObjectMeta: metav1.ObjectMeta{Name: "schedule"}}
+	cr.Spec.ForProvider = testParams()
+	m := &mockService{}
+
+	if err := (&external{client: m, logger: logging.NewNopLogger()}).Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !m.deleted {
+		t.Error("Delete() did not delete the schedule")
+	}
+}
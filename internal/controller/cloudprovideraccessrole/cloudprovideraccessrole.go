@@ -0,0 +1,200 @@
+package cloudprovideraccessrole
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/shared"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotRole         = "managed resource is not a CloudProviderAccessRole custom resource"
+	errGetRole         = "cannot get cloud provider access role"
+	errCreateRole      = "cannot create cloud provider access role"
+	errAuthorizeRole   = "cannot authorize cloud provider access role"
+	errDeauthorizeRole = "cannot deauthorize cloud provider access role"
+	errNoIAMRole       = "waiting for iamAssumedRoleARN to authorize the role"
+
+	errFmtGetRoleRef   = "cannot get CloudProviderAccessRole %s"
+	errFmtRoleNotReady = "CloudProviderAccessRole %s has not been created yet"
+)
+
+// Setup adds a controller that reconciles CloudProviderAccessRole managed
+// resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CloudProviderAccessRoleGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CloudProviderAccessRoleGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.CloudProviderAccessRole{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization that owns
+// the project.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CloudProviderAccessRole)
+	if !ok {
+		return nil, errors.New(errNotRole)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	_, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: client, logger: c.logger}, nil
+}
+
+type external struct {
+	client svc.Service
+	logger logging.Logger
+}
+
+// ReferencedID returns the Atlas ID of the referenced CloudProviderAccessRole.
+func ReferencedID(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, error) {
+	cr := &v1alpha1.CloudProviderAccessRole{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name}, cr); err != nil {
+		return "", errors.Wrapf(err, errFmtGetRoleRef, ref.Name)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return "", errors.Errorf(errFmtRoleNotReady, ref.Name)
+	}
+	return id, nil
+}
+
+// Observe reports the role and its authorization. The external name is the
+// ID of the role in Atlas.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CloudProviderAccessRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRole)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	role, err := c.client.GetCloudProviderAccessRole(ctx, cr.Spec.ForProvider.ProjectID, id)
+	if svc.IsNotFoundError(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRole)
+	}
+
+	authorized := role.AuthorizedDate != nil && role.IAMAssumedRoleARN != ""
+	cr.Status.AtProvider = v1alpha1.CloudProviderAccessRoleObservation{
+		RoleID:                     role.RoleID,
+		AtlasAWSAccountARN:         role.AtlasAWSAccountARN,
+		AtlasAssumedRoleExternalID: role.AtlasAssumedRoleExternalID,
+		IAMAssumedRoleARN:          role.IAMAssumedRoleARN,
+		Authorized:                 authorized,
+		AuthorizedDate:             shared.MetaTime(role.AuthorizedDate),
+		CreatedDate:                shared.MetaTime(role.CreatedDate),
+	}
+	if authorized {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(errNoIAMRole))
+	}
+
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: isUpToDate(cr.Spec.ForProvider, role)}, nil
+}
+
+// Create creates an unauthorized role. The role is authorized by Update once
+// the IAM role trusts the Atlas AWS account.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CloudProviderAccessRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRole)
+	}
+	role, err := c.client.CreateCloudProviderAccessRole(ctx, cr.Spec.ForProvider.ProjectID)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRole)
+	}
+	meta.SetExternalName(cr, role.RoleID)
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update authorizes the IAM role of the spec. Atlas rejects this until the
+// trust policy of the IAM role allows it.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CloudProviderAccessRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRole)
+	}
+	p := cr.Spec.ForProvider
+	if _, err := c.client.AuthorizeCloudProviderAccessRole(ctx, p.ProjectID, meta.GetExternalName(cr), p.IAMAssumedRoleARN); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAuthorizeRole)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CloudProviderAccessRole)
+	if !ok {
+		return errors.New(errNotRole)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := c.client.DeauthorizeCloudProviderAccessRole(ctx, cr.Spec.ForProvider.ProjectID, meta.GetExternalName(cr))
+	if err != nil && !svc.IsNotFoundError(err) {
+		return errors.Wrap(err, errDeauthorizeRole)
+	}
+	return nil
+}
+
+// isUpToDate returns true if the role is authorized for the IAM role of the
+// spec, or if the spec does not name an IAM role yet.
+func isUpToDate(p v1alpha1.CloudProviderAccessRoleParameters, role *svc.CloudProviderAccessRole) bool {
+	if p.IAMAssumedRoleARN == "" {
+		return true
+	}
+	return role.AuthorizedDate != nil && role.IAMAssumedRoleARN == p.IAMAssumedRoleARN
+}
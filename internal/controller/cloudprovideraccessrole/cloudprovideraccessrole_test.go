@@ -0,0 +1,195 @@
+package cloudprovideraccessrole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+const testIAMRole = "arn:aws:iam::123456789012:role/atlas-kms"
+
+// mockService serves a single cloud provider access role.
+type mockService struct {
+	svc.Service
+	role           *svc.CloudProviderAccessRole
+	created        bool
+	deauthorized   bool
+	deauthorizeErr error
+}
+
+func (m *mockService) GetCloudProviderAccessRole(_ context.Context, _, _ string) (*svc.CloudProviderAccessRole, error) {
+	if m.role == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.role, nil
+}
+
+func (m *mockService) CreateCloudProviderAccessRole(_ context.Context, _ string) (*svc.CloudProviderAccessRole, error) {
+	m.created = true
+	return &svc.CloudProviderAccessRole{RoleID: "r1", ProviderName: svc.CloudProviderAWS}, nil
+}
+
+func (m *mockService) DeauthorizeCloudProviderAccessRole(_ context.Context, _, _ string) error {
+	m.deauthorized = true
+	return m.deauthorizeErr
+}
+
+func TestIsUpToDate(t *testing.T) {
+	authorized := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		params v1alpha1.CloudProviderAccessRoleParameters
+		role   svc.CloudProviderAccessRole
+		want   bool
+	}{
+		{
+			name: "NoIAMRoleYet",
+			role: svc.CloudProviderAccessRole{RoleID: "r1"},
+			want: true,
+		},
+		{
+			name:   "NotAuthorized",
+			params: v1alpha1.CloudProviderAccessRoleParameters{IAMAssumedRoleARN: testIAMRole},
+			role:   svc.CloudProviderAccessRole{RoleID: "r1"},
+			want:   false,
+		},
+		{
+			name:   "Authorized",
+			params: v1alpha1.CloudProviderAccessRoleParameters{IAMAssumedRoleARN: testIAMRole},
+			role:   svc.CloudProviderAccessRole{RoleID: "r1", IAMAssumedRoleARN: testIAMRole, AuthorizedDate: &authorized},
+			want:   true,
+		},
+		{
+			name:   "OtherIAMRole",
+			params: v1alpha1.CloudProviderAccessRoleParameters{IAMAssumedRoleARN: testIAMRole},
+			role:   svc.CloudProviderAccessRole{RoleID: "r1", IAMAssumedRoleARN: "arn:aws:iam::123456789012:role/old", AuthorizedDate: &authorized},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isUpToDate(tt.params, &tt.role); got != tt.want {
+				t.Errorf("isUpToDate(...) = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	authorized := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		externalName string
+		role         *svc.CloudProviderAccessRole
+		wantExists   bool
+		wantUpToDate bool
+		wantReady    corev1.ConditionStatus
+	}{
+		{
+			name: "NotCreated",
+		},
+		{
+			name:         "Deleted",
+			externalName: "r1",
+		},
+		{
+			name:         "NotAuthorized",
+			externalName: "r1",
+			role:         &svc.CloudProviderAccessRole{RoleID: "r1"},
+			wantExists:   true,
+			wantReady:    corev1.ConditionFalse,
+		},
+		{
+			name:         "Authorized",
+			externalName: "r1",
+			role:         &svc.CloudProviderAccessRole{RoleID: "r1", IAMAssumedRoleARN: testIAMRole, AuthorizedDate: &authorized},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantReady:    corev1.ConditionTrue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.CloudProviderAccessRole{ObjectMeta: metav1.ObjectMeta{Name: "atlas-kms"}}
+			meta.SetExternalName(cr, tt.externalName)
+			cr.Spec.ForProvider.IAMAssumedRoleARN = testIAMRole
+			e := &external{client: &mockService{role: tt.role}, logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+			if tt.wantExists && cr.GetCondition(xpv1.TypeReady).Status != tt.wantReady {
+				t.Errorf("Observe() Ready = %s, want %s", cr.GetCondition(xpv1.TypeReady).Status, tt.wantReady)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cr := &v1alpha1.CloudProviderAccessRole{ObjectMeta: metav1.ObjectMeta{Name: "atlas-kms"}}
+	m := &mockService{}
+
+	if _, err := (&external{client: m, logger: logging.NewNopLogger()}).Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if got := meta.GetExternalName(cr); got != "r1" {
+		t.Errorf("Create() external name = %q, want %q", got, "r1")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{
+			name: "Deauthorized",
+		},
+		{
+			name: "AlreadyDeleted",
+			err:  &svc.NotFoundError{},
+		},
+		{
+			name:    "Failed",
+			err:     svc.Error{Code: 409, Detail: "role is used for encryption at rest"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.CloudProviderAccessRole{ObjectMeta: metav1.ObjectMeta{Name: "atlas-kms"}}
+			meta.SetExternalName(cr, "r1")
+			m := &mockService{deauthorizeErr: tt.err}
+
+			err := (&external{client: m, logger: logging.NewNopLogger()}).Delete(context.Background(), cr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !m.deauthorized {
+				t.Error("Delete() did not deauthorize the role")
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package flexcluster
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	apisv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/features"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/shared"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotFlexCluster = "managed resource is not a FlexCluster custom resource"
+	errGetCluster     = "cannot get flex cluster"
+	errCreateCluster  = "cannot create flex cluster"
+	errUpdateCluster  = "cannot update flex cluster"
+	errDeleteCluster  = "cannot delete flex cluster"
+)
+
+// Setup adds a controller that reconciles FlexCluster managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FlexClusterGroupKind)
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FlexClusterGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.FlexCluster{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization that owns
+// the project of the cluster.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.FlexCluster)
+	if !ok {
+		return nil, errors.New(errNotFlexCluster)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	_, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: client, logger: c.logger}, nil
+}
+
+type external struct {
+	client svc.Service
+	logger logging.Logger
+}
+
+// clusterName returns the name of the cluster in Atlas.
+func clusterName(cr *v1alpha1.FlexCluster) string {
+	if name := meta.GetExternalName(cr); name != "" {
+		return name
+	}
+	if cr.Spec.ForProvider.Name != "" {
+		return cr.Spec.ForProvider.Name
+	}
+	return cr.Name
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FlexCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFlexCluster)
+	}
+	cluster, err := c.client.GetFlexCluster(ctx, cr.Spec.ForProvider.ProjectID, clusterName(cr))
+	if svc.IsNotFoundError(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCluster)
+	}
+
+	cr.Status.AtProvider.ID = cluster.ID
+	cr.Status.AtProvider.StateName = cluster.StateName
+	cr.Status.AtProvider.MongoDBVersion = cluster.MongoDBVersion
+	cr.Status.AtProvider.BackupEnabled = cluster.BackupSettings != nil && cluster.BackupSettings.Enabled
+	cr.SetConditions(shared.ClusterCondition(cluster.StateName))
+
+	details := managed.ConnectionDetails{}
+	if cluster.ConnectionStrings != nil && cluster.ConnectionStrings.StandardSrv != "" {
+		details["srvConnectionString"] = []byte(cluster.ConnectionStrings.StandardSrv)
+	}
+
+	// Atlas rejects updates while the cluster is changing.
+	upToDate := cluster.StateName != svc.ClusterStateIdle || isUpToDate(cr.Spec.ForProvider, cluster)
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate, ConnectionDetails: details}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FlexCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFlexCluster)
+	}
+	p := cr.Spec.ForProvider
+	name := clusterName(cr)
+	_, err := c.client.CreateFlexCluster(ctx, p.ProjectID, svc.FlexCluster{
+		Name: name,
+		ProviderSettings: &svc.FlexProviderSettings{
+			BackingProviderName: p.ProviderName,
+			RegionName:          p.RegionName,
+		},
+		TerminationProtectionEnabled: pointer.Bool(p.TerminationProtectionEnabled),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	}
+	meta.SetExternalName(cr, name)
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update changes the termination protection. The provider and region of a
+// cluster cannot be changed.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FlexCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFlexCluster)
+	}
+	p := cr.Spec.ForProvider
+	_, err := c.client.UpdateFlexCluster(ctx, p.ProjectID, clusterName(cr), svc.FlexCluster{
+		TerminationProtectionEnabled: pointer.Bool(p.TerminationProtectionEnabled),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FlexCluster)
+	if !ok {
+		return errors.New(errNotFlexCluster)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.StateName == svc.ClusterStateDeleting {
+		return nil
+	}
+	err := c.client.DeleteFlexCluster(ctx, cr.Spec.ForProvider.ProjectID, clusterName(cr))
+	if err != nil && !svc.IsNotFoundError(err) {
+		return errors.Wrap(err, errDeleteCluster)
+	}
+	return nil
+}
+
+// isUpToDate returns true if the updatable settings of the cluster match the
+// spec.
+func isUpToDate(p v1alpha1.FlexClusterParameters, cluster *svc.FlexCluster) bool {
+	return pointer.BoolDeref(cluster.TerminationProtectionEnabled, false) == p.TerminationProtectionEnabled
+}
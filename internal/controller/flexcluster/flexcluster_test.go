@@ -0,0 +1,157 @@
+package flexcluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// mockService serves a single flex cluster.
+type mockService struct {
+	svc.Service
+	cluster *svc.FlexCluster
+	updated *svc.FlexCluster
+}
+
+func (m *mockService) GetFlexCluster(_ context.Context, _, _ string) (*svc.FlexCluster, error) {
+	if m.cluster == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.cluster, nil
+}
+
+func (m *mockService) UpdateFlexCluster(_ context.Context, _, _ string, cluster svc.FlexCluster) (*svc.FlexCluster, error) {
+	m.updated = &cluster
+	return &cluster, nil
+}
+
+func TestObserve(t *testing.T) {
+	tests := []struct {
+		name         string
+		cluster      *svc.FlexCluster
+		protection   bool
+		wantExists   bool
+		wantUpToDate bool
+		wantReady    corev1.ConditionStatus
+		wantReason   xpv1.ConditionReason
+		wantDetails  bool
+	}{
+		{
+			name: "NotFound",
+		},
+		{
+			name: "Available",
+			cluster: &svc.FlexCluster{
+				StateName:                    svc.ClusterStateIdle,
+				TerminationProtectionEnabled: pointer.Bool(false),
+				ConnectionStrings:            &svc.ConnectionStrings{StandardSrv: "mongodb+srv://flex.example.net"},
+			},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantReady:    corev1.ConditionTrue,
+			wantReason:   xpv1.ReasonAvailable,
+			wantDetails:  true,
+		},
+		{
+			name:         "TerminationProtectionDrifted",
+			cluster:      &svc.FlexCluster{StateName: svc.ClusterStateIdle},
+			protection:   true,
+			wantExists:   true,
+			wantUpToDate: false,
+			wantReady:    corev1.ConditionTrue,
+			wantReason:   xpv1.ReasonAvailable,
+		},
+		{
+			// Atlas rejects updates while the cluster is being created.
+			name:         "Creating",
+			cluster:      &svc.FlexCluster{StateName: svc.ClusterStateCreating},
+			protection:   true,
+			wantExists:   true,
+			wantUpToDate: true,
+			wantReady:    corev1.ConditionFalse,
+			wantReason:   xpv1.ReasonCreating,
+		},
+		{
+			name:         "Repairing",
+			cluster:      &svc.FlexCluster{StateName: svc.ClusterStateRepairing},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantReady:    corev1.ConditionFalse,
+			wantReason:   xpv1.ReasonUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.FlexCluster{ObjectMeta: metav1.ObjectMeta{Name: "flex"}}
+			cr.Spec.ForProvider.TerminationProtectionEnabled = tt.protection
+			e := &external{client: &mockService{cluster: tt.cluster}, logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if !tt.wantExists {
+				return
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+			ready := cr.GetCondition(xpv1.TypeReady)
+			if ready.Status != tt.wantReady || ready.Reason != tt.wantReason {
+				t.Errorf("Observe() Ready = %s/%s, want %s/%s", ready.Status, ready.Reason, tt.wantReady, tt.wantReason)
+			}
+			if _, ok := obs.ConnectionDetails["srvConnectionString"]; ok != tt.wantDetails {
+				t.Errorf("Observe() srvConnectionString published = %v, want %v", ok, tt.wantDetails)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		protection bool
+		want       *svc.FlexCluster
+	}{
+		{
+			name:       "EnableTerminationProtection",
+			protection: true,
+			want:       &svc.FlexCluster{TerminationProtectionEnabled: pointer.Bool(true)},
+		},
+		{
+			name: "DisableTerminationProtection",
+			want: &svc.FlexCluster{TerminationProtectionEnabled: pointer.Bool(false)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.FlexCluster{ObjectMeta: metav1.ObjectMeta{Name: "flex"}}
+			meta.SetExternalName(cr, "flex")
+			cr.Spec.ForProvider.TerminationProtectionEnabled = tt.protection
+			m := &mockService{}
+
+			if _, err := (&external{client: m, logger: logging.NewNopLogger()}).Update(context.Background(), cr); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, m.updated); diff != "" {
+				t.Errorf("Update() -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
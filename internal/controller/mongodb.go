@@ -20,8 +20,19 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/backuprestorejob"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/backupsnapshot"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/cloudbackupschedule"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/cloudprovideraccessrole"
 	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/config"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/flexcluster"
 	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organizationinvitation"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organizationuser"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/projectencryptionatrest"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/projectteamassignment"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/serverlessinstance"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/team"
 	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/vpcendpoint"
 )
 
@@ -29,8 +40,19 @@ import (
 // the supplied manager.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		backuprestorejob.Setup,
+		backupsnapshot.Setup,
+		cloudbackupschedule.Setup,
+		cloudprovideraccessrole.Setup,
 		config.Setup,
+		flexcluster.Setup,
 		organization.Setup,
+		organizationinvitation.Setup,
+		organizationuser.Setup,
+		projectencryptionatrest.Setup,
+		projectteamassignment.Setup,
+		serverlessinstance.Setup,
+		team.Setup,
 		vpcendpoint.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
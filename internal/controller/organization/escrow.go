@@ -0,0 +1,100 @@
+package organization
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+)
+
+const (
+	// escrowKey is the key of the escrowed credentials in an escrow secret.
+	escrowKey = "credentials"
+	// escrowLabel labels escrow secrets with the name of their organization.
+	escrowLabel = "organization.mongodb.allianz.io/name"
+	// defaultEscrowNamespace is used when the namespace of the provider is
+	// unknown.
+	defaultEscrowNamespace = "crossplane-system"
+
+	errGetEscrow    = "cannot get escrowed API key of organization"
+	errPutEscrow    = "cannot escrow API key of organization"
+	errDeleteEscrow = "cannot delete escrowed API key of organization"
+)
+
+// escrow holds the API key of an organization in a Kubernetes secret in the
+// namespace of the provider until it was written to the credential sink.
+// Atlas returns the private key only once, on creation.
+type escrow struct {
+	kube      client.Client
+	namespace string
+}
+
+// escrowNamespace returns the namespace of the provider.
+func escrowNamespace() string {
+	if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
+		return ns
+	}
+	return defaultEscrowNamespace
+}
+
+func (e escrow) key(cr *v1alpha1.Organization) types.NamespacedName {
+	return types.NamespacedName{Namespace: e.namespace, Name: "organization-escrow-" + string(cr.GetUID())}
+}
+
+// Get returns the escrowed API key of an organization, or nil if there is
+// none.
+func (e escrow) Get(ctx context.Context, cr *v1alpha1.Organization) (*awsclient.MongoDBAPICredentials, error) {
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, e.key(cr), s); err != nil {
+		return nil, resource.Ignore(kerrors.IsNotFound, err)
+	}
+	creds := &awsclient.MongoDBAPICredentials{}
+	if err := json.Unmarshal(s.Data[escrowKey], creds); err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
+
+// Put escrows the API key of an organization.
+func (e escrow) Put(ctx context.Context, cr *v1alpha1.Organization, creds awsclient.MongoDBAPICredentials) error {
+	data, err := json.Marshal(creds)
+	if err != nil {
+		return err
+	}
+	key := e.key(cr)
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: key.Namespace,
+			Name:      key.Name,
+			Labels:    map[string]string{escrowLabel: cr.GetName()},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{escrowKey: data},
+	}
+	if err := e.kube.Create(ctx, s); !kerrors.IsAlreadyExists(err) {
+		return err
+	}
+	existing := &corev1.Secret{}
+	if err := e.kube.Get(ctx, key, existing); err != nil {
+		return err
+	}
+	existing.Data = s.Data
+	return e.kube.Update(ctx, existing)
+}
+
+// Delete removes the escrowed API key of an organization.
+func (e escrow) Delete(ctx context.Context, cr *v1alpha1.Organization) error {
+	key := e.key(cr)
+	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+	return resource.IgnoreNotFound(e.kube.Delete(ctx, s))
+}
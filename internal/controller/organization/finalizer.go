@@ -0,0 +1,37 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errUpdateFinalizers = "cannot remove organization finalizers"
+
+// managedFinalizer is the finalizer of the managed reconciler. The reconciler
+// removes it without calling Observe or Delete when an organization is
+// orphaned, so removing it also removes the cleanup finalizer.
+type managedFinalizer struct {
+	kube client.Client
+}
+
+// AddFinalizer adds the finalizer of the managed reconciler.
+func (f managedFinalizer) AddFinalizer(ctx context.Context, obj resource.Object) error {
+	return resource.NewAPIFinalizer(f.kube, managed.FinalizerName).AddFinalizer(ctx, obj)
+}
+
+// RemoveFinalizer removes the finalizer of the managed reconciler and the
+// cleanup finalizer.
+func (f managedFinalizer) RemoveFinalizer(ctx context.Context, obj resource.Object) error {
+	if !meta.FinalizerExists(obj, managed.FinalizerName) && !meta.FinalizerExists(obj, FinalizerOrganizationCleanup) {
+		return nil
+	}
+	meta.RemoveFinalizer(obj, managed.FinalizerName)
+	meta.RemoveFinalizer(obj, FinalizerOrganizationCleanup)
+	return errors.Wrap(resource.IgnoreNotFound(f.kube.Update(ctx, obj)), errUpdateFinalizers)
+}
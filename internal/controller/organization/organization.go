@@ -2,9 +2,20 @@ package organization
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"strings"
+	"text/template"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -12,6 +23,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -22,19 +34,71 @@ import (
 	apisv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
 	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
 	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/sink"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/vault"
 	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/features"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
 )
 
 const (
 	errNotOrganization = "managed resource is not an Organization custom resource"
 	errTrackPCUsage    = "cannot track ProviderConfig usage"
 	errGetPC           = "cannot get ProviderConfig"
-	errInvalidPCConfig = "ProviderConfig must use AWS Secret containing publicKey and privateKey"
+	errInvalidPCConfig = "ProviderConfig must configure AWS Secrets Manager unless credentialSink is Vault"
 	errAWSClient       = "cannot create AWS client"
 	errNoOrgSecret     = "org API key secret not set in status"
 	secretPrefix       = "product/mongodb/"
 	errObserveExternal = "cannot observe external organization"
 	errDeleteExternal  = "cannot delete external organization"
+	errVerifyDeletion  = "cannot verify deletion of external organization"
+	errListProjects    = "cannot list projects of organization"
+	errDeleteSecret    = "cannot delete secret of deleted organization"
+	errTagSecret       = "cannot tag secret of deleted organization as orphaned"
+	errAddFinalizer    = "cannot add organization cleanup finalizer"
+	errRemoveFinalizer = "cannot remove organization cleanup finalizer"
+	errNoAPIKey        = "spec.forProvider.apiKey is required to create an organization"
+
+	errDescribeSecret     = "cannot describe secret of organization"
+	errMoveSecret         = "cannot move secret of organization to its new name"
+	errSecretNameTemplate = "cannot render secret name template of ProviderConfig"
+	errEmptySecretName    = "secret name template of ProviderConfig rendered an empty name"
+	errNoVaultConfig      = "vaultConfig is required when credentialSink is Vault"
+	errNoPCVaultConfig    = "vault is required when the credentials source of the ProviderConfig is Vault"
+	errGetPCCredentials   = "cannot get credentials of ProviderConfig"
+	errGetStoreConfig     = "cannot get StoreConfig"
+	errVaultClient        = "cannot create Vault client"
+	errGetResourcePolicy  = "cannot get resource policy of secret of organization"
+	errValidateKMSKey     = "cannot use KMS key for secret of organization"
+	errFindOrganization   = "cannot look up organizations with the same name"
+	errGetOrganization    = "cannot get referenced Organization"
+	errGetOrganizationKey = "cannot get API key of referenced Organization"
+	errGetSettings        = "cannot get settings of organization"
+	errUpdateSettings     = "cannot update settings of organization"
+	errGetAPIKeyID        = "cannot read API key ID of organization from its secret"
+	errNoAPIKeyID         = "secret of organization does not contain the API key ID"
+	errGetAccessList      = "cannot get access list of organization API key"
+	errUpdateAccessList   = "cannot update access list of organization API key"
+
+	errFmtDeleteProject   = "cannot delete child project %s"
+	errFmtDeletionBlocked = "deletion blocked until projects are deleted: %s"
+
+	errFmtCredentialsSource    = "unsupported credentials source %q of ProviderConfig"
+	errFmtOrganizationExists   = "organization %s already exists in Atlas as %s, set its external name to import it"
+	errFmtOrganizationNotReady = "referenced Organization %s has not been created yet"
+	errFmtKeyNotPersisted      = "API key of organization %s could not be persisted, replace it in Atlas"
+	errFmtSecretOrphaned       = "secret %s was retained for deleted organization %s, delete it or choose another secret name"
+
+	reasonStateChanged      event.Reason = "StateChanged"
+	reasonSecretMoved       event.Reason = "SecretMoved"
+	reasonCreateResumed     event.Reason = "CreateResumed"
+	reasonSettingsUpdated   event.Reason = "SettingsUpdated"
+	reasonAccessListUpdated event.Reason = "AccessListUpdated"
+	reasonEscrowFailed      event.Reason = "EscrowFailed"
+	reasonNameCheckSkipped  event.Reason = "NameCheckSkipped"
+
+	// Tags identifying the resource that manages a secret.
+	tagCrossplaneName = "CrossplaneName"
+	tagCrossplaneUID  = "CrossplaneUID"
 )
 
 // ADD: Deletion finalizer constant
@@ -50,35 +114,54 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
-	r := managed.NewReconciler(
-		mgr,
-		resource.ManagedKind(v1alpha1.OrganizationGroupVersionKind),
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	managementPolicies := o.Features.Enabled(feature.EnableAlphaManagementPolicies)
+	opts := []managed.ReconcilerOption{
 		managed.WithExternalConnecter(&connector{
-			kube:           mgr.GetClient(),
-			usage:          resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
-			logger:         o.Logger,
-			newServiceFn:   svc.NewService,
-			newAWSClientFn: awsclient.NewClient,
+			kube:               mgr.GetClient(),
+			usage:              resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger:             o.Logger,
+			recorder:           recorder,
+			newServiceFn:       svc.NewService,
+			newAWSClientFn:     awsclient.NewSecretStore,
+			escrowNamespace:    escrowNamespace(),
+			managementPolicies: managementPolicies,
 		}),
 		managed.WithInitializers(),
+		managed.WithFinalizer(managedFinalizer{kube: mgr.GetClient()}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
+	}
+	if managementPolicies {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(
+		mgr,
+		resource.ManagedKind(v1alpha1.OrganizationGroupVersionKind),
+		opts...,
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.Organization{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
 }
 
 type connector struct {
 	kube           client.Client
 	usage          resource.Tracker
 	logger         logging.Logger
+	recorder       event.Recorder
 	newServiceFn   func(creds svc.Credentials) svc.Service
-	newAWSClientFn func(ctx context.Context, region string) (*awsclient.Client, error)
+	newAWSClientFn func(ctx context.Context, region string) (awsclient.SecretStore, error)
+
+	// escrowNamespace holds the API keys of organizations being created.
+	escrowNamespace string
+	// managementPolicies is true if management policies are enabled.
+	managementPolicies bool
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -86,54 +169,225 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if !ok {
 		return nil, errors.New(errNotOrganization)
 	}
+	tracing.AnnotateResource(ctx, mg)
 	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
+	return c.connect(ctx, cr)
+}
 
+// connect connects to Atlas with the credentials of the ProviderConfig of an
+// organization and to the sink holding the API key of the organization.
+func (c *connector) connect(ctx context.Context, cr *v1alpha1.Organization) (*external, error) {
 	pc := &apisv1alpha1.ProviderConfig{}
 	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, errGetPC)
 	}
-	if pc.Spec.Credentials.Source != CredentialsSourceAWS {
-		return nil, errors.New(errInvalidPCConfig)
+
+	// AWS Secrets Manager is only needed to read the credentials of the
+	// ProviderConfig from it, or to write the API key of the organization.
+	var awsClient awsclient.SecretStore
+	if pc.Spec.Credentials.Source == CredentialsSourceAWS || !usesVault(cr) {
+		aws := pc.Spec.Credentials.AWS
+		if aws == nil || aws.SecretsManager == nil {
+			return nil, errors.New(errInvalidPCConfig)
+		}
+		var err error
+		if awsClient, err = c.newAWSClientFn(ctx, aws.SecretsManager.Region); err != nil {
+			return nil, errors.Wrap(err, errAWSClient)
+		}
 	}
 
-	awsCfg := pc.Spec.Credentials.AWS.SecretsManager
-	awsClient, err := c.newAWSClientFn(ctx, awsCfg.Region)
+	creds, err := c.providerCredentials(ctx, pc, awsClient)
 	if err != nil {
-		return nil, errors.Wrap(err, errAWSClient)
+		return nil, err
 	}
 
-	credsAWS, err := awsClient.GetSecret(ctx, *awsCfg.SecretName)
+	credSink, name, err := c.credentialSink(ctx, pc, cr, awsClient)
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot get AWS secret from ProviderConfig")
+		return nil, err
 	}
-	creds := svc.Credentials{PublicKey: credsAWS.PublicKey, PrivateKey: credsAWS.PrivateKey}
 
 	return &external{
 		kube:         c.kube,
 		client:       c.newServiceFn(creds),
 		logger:       c.logger,
+		recorder:     c.recorder,
+		finalizer:    resource.NewAPIFinalizer(c.kube, FinalizerOrganizationCleanup),
 		awsClient:    awsClient,
+		sink:         credSink,
+		escrow:       escrow{kube: c.kube, namespace: c.escrowNamespace},
+		secretName:   name,
 		newServiceFn: c.newServiceFn,
+
+		managementPolicies: c.managementPolicies,
 	}, nil
 }
 
+// providerCredentials reads the Atlas credentials of a ProviderConfig from
+// AWS Secrets Manager, Vault or a Kubernetes secret.
+func (c *connector) providerCredentials(ctx context.Context, pc *apisv1alpha1.ProviderConfig, awsClient awsclient.SecretStore) (svc.Credentials, error) {
+	var creds *awsclient.MongoDBAPICredentials
+	var err error
+	switch src := pc.Spec.Credentials; src.Source {
+	case CredentialsSourceAWS:
+		creds, err = awsClient.GetSecret(ctx, pointer.StringDeref(src.AWS.SecretsManager.SecretName, ""))
+	case apisv1alpha1.CredentialsSourceVault:
+		if src.Vault == nil {
+			return svc.Credentials{}, errors.New(errNoPCVaultConfig)
+		}
+		sc := &apisv1alpha1.StoreConfig{}
+		if err := c.kube.Get(ctx, types.NamespacedName{Name: src.Vault.StoreConfigRef.Name}, sc); err != nil {
+			return svc.Credentials{}, errors.Wrap(err, errGetStoreConfig)
+		}
+		var vc *vault.Client
+		if vc, err = vault.NewClient(ctx, c.kube, sc, awsclient.PayloadFormat{}); err != nil {
+			return svc.Credentials{}, errors.Wrap(err, errVaultClient)
+		}
+		creds, err = vc.Get(ctx, src.Vault.Path)
+	case xpv1.CredentialsSourceSecret:
+		var data []byte
+		data, err = resource.CommonCredentialExtractor(ctx, src.Source, c.kube, src.CommonCredentialSelectors)
+		if err == nil {
+			creds, err = awsclient.PayloadFormat{}.Unmarshal(data)
+		}
+	default:
+		return svc.Credentials{}, errors.Errorf(errFmtCredentialsSource, src.Source)
+	}
+	if err != nil {
+		return svc.Credentials{}, errors.Wrap(err, errGetPCCredentials)
+	}
+	return svc.Credentials{PublicKey: creds.PublicKey, PrivateKey: creds.PrivateKey}, nil
+}
+
+// credentialSink returns the sink holding the API key of an organization and
+// the name the key should have in it.
+func (c *connector) credentialSink(ctx context.Context, pc *apisv1alpha1.ProviderConfig, cr *v1alpha1.Organization, awsClient awsclient.SecretStore) (sink.Sink, string, error) {
+	name, err := secretName(pc, cr)
+	if err != nil {
+		return nil, "", err
+	}
+	if usesVault(cr) {
+		return c.vaultSink(ctx, cr, name)
+	}
+	return &sink.SecretsManager{
+		Client:               awsClient,
+		Format:               payloadFormat(cr),
+		Options:              secretOptions(cr),
+		RecoveryWindowInDays: pointer.Int64Deref(cr.Spec.ForProvider.AWSSecretsConfig.RecoveryWindowInDays, awsclient.DefaultRecoveryWindowInDays),
+	}, name, nil
+}
+
+// vaultSink connects to the Vault configured for an organization. It returns
+// the path of the secret of the organization.
+func (c *connector) vaultSink(ctx context.Context, cr *v1alpha1.Organization, name string) (sink.Sink, string, error) {
+	ref := cr.Spec.ForProvider.VaultConfig
+	if ref == nil {
+		return nil, "", errors.New(errNoVaultConfig)
+	}
+	sc := &apisv1alpha1.StoreConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: ref.StoreConfigRef.Name}, sc); err != nil {
+		return nil, "", errors.Wrap(err, errGetStoreConfig)
+	}
+	vc, err := vault.NewClient(ctx, c.kube, sc, payloadFormat(cr))
+	if err != nil {
+		return nil, "", errors.Wrap(err, errVaultClient)
+	}
+	return vc, pointer.StringDeref(ref.Path, name), nil
+}
+
+// usesVault reports whether the API key of an organization is written to
+// Vault instead of AWS Secrets Manager.
+func usesVault(cr *v1alpha1.Organization) bool {
+	return cr.Spec.ForProvider.CredentialSink == v1alpha1.CredentialSinkVault
+}
+
 type external struct {
 	kube         client.Client
 	client       svc.Service
 	logger       logging.Logger
-	awsClient    *awsclient.Client
+	recorder     event.Recorder
+	finalizer    resource.Finalizer
+	awsClient    awsclient.SecretStore
+	sink         sink.Sink
+	escrow       escrow
 	newServiceFn func(creds svc.Credentials) svc.Service
+
+	// secretName is the name the secret of the organization should have.
+	secretName string
+	// managementPolicies is true if management policies are enabled.
+	managementPolicies bool
 }
 
-// derive final secret name
-func finalSecretName(cr *v1alpha1.Organization) string {
-	if cr.Spec.ForProvider.AWSSecretsConfig.SecretName != nil &&
-		*cr.Spec.ForProvider.AWSSecretsConfig.SecretName != "" {
-		return secretPrefix + *cr.Spec.ForProvider.AWSSecretsConfig.SecretName
+// secretNameData is available to secret name templates.
+type secretNameData struct {
+	Name           string
+	Env            string
+	ProviderConfig string
+}
+
+// secretName derives the name of the secret holding the API key of an
+// organization from the secret naming of its ProviderConfig.
+func secretName(pc *apisv1alpha1.ProviderConfig, cr *v1alpha1.Organization) (string, error) {
+	name := cr.Name
+	if n := cr.Spec.ForProvider.AWSSecretsConfig.SecretName; n != nil && *n != "" {
+		name = *n
+	}
+	naming := pc.Spec.SecretNaming
+	if naming == nil {
+		return secretPrefix + name, nil
 	}
-	return secretPrefix + cr.Name
+	if naming.Template == nil || *naming.Template == "" {
+		return pointer.StringDeref(naming.Prefix, secretPrefix) + name, nil
+	}
+
+	t, err := template.New("secretName").Parse(*naming.Template)
+	if err != nil {
+		return "", errors.Wrap(err, errSecretNameTemplate)
+	}
+	b := &strings.Builder{}
+	if err := t.Execute(b, secretNameData{Name: name, Env: naming.Env, ProviderConfig: pc.Name}); err != nil {
+		return "", errors.Wrap(err, errSecretNameTemplate)
+	}
+	if b.Len() == 0 {
+		return "", errors.New(errEmptySecretName)
+	}
+	return b.String(), nil
+}
+
+// storedSecretName returns the name of the existing secret of an
+// organization. It differs from secretName until the secret was moved after
+// the secret naming changed.
+func (c *external) storedSecretName(cr *v1alpha1.Organization) string {
+	if n := cr.Status.AtProvider.SecretName; n != "" {
+		return n
+	}
+	return c.secretName
+}
+
+// payloadFormat returns the format of the secret holding the organization API
+// key.
+func payloadFormat(cr *v1alpha1.Organization) awsclient.PayloadFormat {
+	p := cr.Spec.ForProvider.SecretPayload
+	if p == nil {
+		return awsclient.PayloadFormat{}
+	}
+	return awsclient.PayloadFormat{Fields: p.Fields, KeyNames: p.KeyNames}
+}
+
+// setState moves the organization to the given state and records an event
+// when the state changes.
+func (c *external) setState(cr *v1alpha1.Organization, state string) {
+	previous := pointer.StringDeref(cr.Status.AtProvider.State, "")
+	if previous == state {
+		return
+	}
+	cr.Status.AtProvider.State = pointer.String(state)
+	msg := fmt.Sprintf("Organization state changed from %s to %s", previous, state)
+	if previous == "" {
+		msg = fmt.Sprintf("Organization state set to %s", state)
+	}
+	c.recorder.Event(cr, event.Normal(reasonStateChanged, msg))
 }
 
 // ADD: Enhanced Observe with deletion state detection
@@ -145,77 +399,875 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	cr.Status.AtProvider.OrgID = orgID
 
-	// ADD: Check if resource is marked for deletion
-	if cr.DeletionTimestamp != nil {
+	if meta.WasDeleted(cr) {
 		c.logger.Debug("Organization marked for deletion", "name", cr.Name, "orgID", orgID)
-		cr.SetConditions(xpv1.Deleting())
-		return managed.ExternalObservation{ResourceExists: true}, nil
+		return c.observeDeletion(ctx, cr, orgID)
+	}
+
+	// Verify the organization exists in Atlas. This also allows existing
+	// organizations to be imported by setting the external name.
+	org, err := c.getOrganization(ctx, cr, orgID)
+	if svc.IsNotFoundError(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveExternal)
+	}
+	if org.IsDeleted {
+		c.setState(cr, v1alpha1.OrganizationStateDeleted)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.Status.AtProvider.OrgName = org.Name
+	if !org.Created.IsZero() {
+		cr.Status.AtProvider.CreatedAt = &metav1.Time{Time: org.Created}
+	}
+	c.setState(cr, v1alpha1.OrganizationStateActive)
+
+	// Imported organizations get the cleanup finalizer here. It cannot be
+	// added once the object is being deleted.
+	if err := c.syncFinalizer(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	_, drift, err := c.observeSettings(ctx, cr, orgID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if len(drift) > 0 {
+		c.logger.Debug("Organization settings drifted", "orgID", orgID, "settings", drift)
+	}
+
+	// An escrowed API key is stored by Update.
+	pending, err := c.escrow.Get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEscrow)
+	}
+	if pending != nil {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	add, remove, err := c.observeAccessList(ctx, cr, orgID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if len(add) > 0 || len(remove) > 0 {
+		c.logger.Debug("API key access list drifted", "orgID", orgID, "add", add, "remove", remove)
+	}
+	orgUpToDate := len(drift) == 0 && len(add) == 0 && len(remove) == 0
+
+	// Secrets in Vault are only written when the organization is created.
+	if usesVault(cr) {
+		cr.SetConditions(xpv1.Available())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: orgUpToDate}, nil
+	}
+
+	secretName := c.secretName
+	if stored := cr.Status.AtProvider.SecretName; stored != "" && stored != secretName {
+		c.logger.Debug("Secret name changed", "from", stored, "to", secretName)
+		cr.SetConditions(xpv1.Available())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
 	}
 
 	// refresh secret metadata
-	secretName := finalSecretName(cr)
 	desc, err := c.awsClient.DescribeSecret(ctx, secretName)
 	if err != nil {
 		c.logger.Debug("Failed to describe secret", "error", err, "secretName", secretName)
 		// Don't fail if secret doesn't exist - it might be cleaned up
 		cr.SetConditions(xpv1.Available())
-		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: orgUpToDate}, nil
 	}
 	
 	if desc.ARN != nil {
 		cr.Status.AtProvider.SecretName = secretName
 		cr.Status.AtProvider.SecretARN = *desc.ARN
 	}
+	// Secrets Manager reports the key as it was specified, which may be an
+	// alias. Keep the key ARN resolved on creation unless it reports a key ARN.
+	if keyID := aws.ToString(desc.KmsKeyId); strings.Contains(keyID, ":key/") || cr.Status.AtProvider.KMSKeyID == "" {
+		cr.Status.AtProvider.KMSKeyID = keyID
+	}
+	// Atlas does not report when an organization was created, the secret
+	// holding its initial API key is written right after.
+	if cr.Status.AtProvider.CreatedAt == nil && desc.CreatedDate != nil {
+		cr.Status.AtProvider.CreatedAt = &metav1.Time{Time: *desc.CreatedDate}
+	}
+
+	cr.Status.AtProvider.ReplicationStatus = replicationStatus(desc.ReplicationStatus)
+
+	upToDate, err := c.secretUpToDate(ctx, cr, desc)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	cr.SetConditions(xpv1.Available())
-	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate && orgUpToDate}, nil
+}
+
+// secretUpToDate reports whether the tags and resource policy of the secret
+// of an organization match its spec.
+func (c *external) secretUpToDate(ctx context.Context, cr *v1alpha1.Organization, desc *secretsmanager.DescribeSecretOutput) (bool, error) {
+	set, remove := awsclient.DiffTags(desc.Tags, secretTags(cr))
+	if len(set) > 0 || len(remove) > 0 {
+		return false, nil
+	}
+	add, drop := awsclient.DiffReplicaRegions(desc.ReplicationStatus, replicaRegions(cr))
+	if len(add) > 0 || len(drop) > 0 {
+		return false, nil
+	}
+	put, removePolicy, err := c.diffResourcePolicy(ctx, cr, c.secretName)
+	return put == nil && !removePolicy, err
+}
+
+// diffResourcePolicy returns the resource policy to put on the secret of an
+// organization, and whether its current policy must be deleted because the
+// spec no longer sets one.
+func (c *external) diffResourcePolicy(ctx context.Context, cr *v1alpha1.Organization, secretName string) (*string, bool, error) {
+	current, err := c.awsClient.GetResourcePolicy(ctx, secretName)
+	if err != nil {
+		return nil, false, errors.Wrap(err, errGetResourcePolicy)
+	}
+	switch want := cr.Spec.ForProvider.AWSSecretsConfig.ResourcePolicy; {
+	case want == nil:
+		return nil, current != nil, nil
+	case current == nil || !awsclient.PolicyEqual(*current, *want):
+		return want, false, nil
+	}
+	return nil, false, nil
+}
+
+// secretOptions returns the settings of the secret of an organization in AWS
+// Secrets Manager.
+func secretOptions(cr *v1alpha1.Organization) awsclient.SecretOptions {
+	return awsclient.SecretOptions{
+		KMSKeyID:       cr.Spec.ForProvider.AWSSecretsConfig.KMSKeyID,
+		Tags:           resourceTags(cr),
+		ResourcePolicy: cr.Spec.ForProvider.AWSSecretsConfig.ResourcePolicy,
+		ReplicaRegions: replicaRegions(cr),
+	}
+}
+
+// replicaRegions returns the regions the secret of an organization should be
+// replicated to.
+func replicaRegions(cr *v1alpha1.Organization) []awsclient.ReplicaRegion {
+	regions := make([]awsclient.ReplicaRegion, 0, len(cr.Spec.ForProvider.AWSSecretsConfig.ReplicaRegions))
+	for _, r := range cr.Spec.ForProvider.AWSSecretsConfig.ReplicaRegions {
+		regions = append(regions, awsclient.ReplicaRegion{Region: r.Region, KMSKeyID: r.KMSKeyID})
+	}
+	return regions
+}
+
+// replicationStatus converts the replication status reported by Secrets
+// Manager.
+func replicationStatus(in []smtypes.ReplicationStatusType) []v1alpha1.SecretReplicationStatus {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]v1alpha1.SecretReplicationStatus, 0, len(in))
+	for _, r := range in {
+		out = append(out, v1alpha1.SecretReplicationStatus{
+			Region:        aws.ToString(r.Region),
+			Status:        string(r.Status),
+			StatusMessage: aws.ToString(r.StatusMessage),
+			KMSKeyID:      aws.ToString(r.KmsKeyId),
+		})
+	}
+	return out
+}
+
+// resourceTags returns the tags of the secret of an organization that are set
+// in addition to the default tags of the provider.
+func resourceTags(cr *v1alpha1.Organization) map[string]string {
+	tags := map[string]string{}
+	for k, v := range cr.Spec.ForProvider.AWSSecretsConfig.Tags {
+		tags[k] = v
+	}
+	tags[tagCrossplaneName] = cr.GetName()
+	tags[tagCrossplaneUID] = string(cr.GetUID())
+	return tags
+}
+
+// secretTags returns all tags the secret of an organization should carry.
+func secretTags(cr *v1alpha1.Organization) map[string]string {
+	tags := awsclient.DefaultTags(meta.GetExternalName(cr))
+	for k, v := range resourceTags(cr) {
+		tags[k] = v
+	}
+	return tags
+}
+
+// getOrganization reads the organization with the ProviderConfig credentials.
+// Organizations created by the provider may only be readable with their own
+// API key, which is used as a fallback.
+func (c *external) getOrganization(ctx context.Context, cr *v1alpha1.Organization, orgID string) (*svc.Organization, error) {
+	var org *svc.Organization
+	err := c.withOrgClient(ctx, cr, func(client svc.Service) error {
+		var err error
+		org, err = client.GetOrganization(ctx, orgID)
+		return err
+	})
+	return org, err
+}
+
+// withOrgClient calls fn with the Atlas client of the provider. If the
+// provider is not authorized, fn is retried with the API key of the
+// organization.
+func (c *external) withOrgClient(ctx context.Context, cr *v1alpha1.Organization, fn func(svc.Service) error) error {
+	err := fn(c.client)
+	if !svc.IsUnauthorizedError(err) {
+		return err
+	}
+	creds, secretErr := c.sink.Get(ctx, c.storedSecretName(cr))
+	if secretErr != nil {
+		return err
+	}
+	return fn(c.newServiceFn(svc.Credentials{PublicKey: creds.PublicKey, PrivateKey: creds.PrivateKey}))
+}
+
+// observeSettings records the security settings of an organization and
+// returns the ones that differ from its spec.
+func (c *external) observeSettings(ctx context.Context, cr *v1alpha1.Organization, orgID string) (svc.OrganizationSettings, []string, error) {
+	want := cr.Spec.ForProvider.Settings
+	if want == nil {
+		cr.Status.AtProvider.Settings = nil
+		return svc.OrganizationSettings{}, nil, nil
+	}
+	var got *svc.OrganizationSettings
+	err := c.withOrgClient(ctx, cr, func(client svc.Service) error {
+		var err error
+		got, err = client.GetOrganizationSettings(ctx, orgID)
+		return err
+	})
+	if err != nil {
+		return svc.OrganizationSettings{}, nil, errors.Wrap(err, errGetSettings)
+	}
+	cr.Status.AtProvider.Settings = &v1alpha1.OrganizationSettings{
+		APIAccessListRequired:   got.APIAccessListRequired,
+		MultiFactorAuthRequired: got.MultiFactorAuthRequired,
+		RestrictEmployeeAccess:  got.RestrictEmployeeAccess,
+		GenAIFeaturesEnabled:    got.GenAIFeaturesEnabled,
+		SecurityContact:         got.SecurityContact,
+	}
+
+	patch := svc.OrganizationSettings{}
+	var drift []string
+	if differs(want.APIAccessListRequired, got.APIAccessListRequired) {
+		patch.APIAccessListRequired = want.APIAccessListRequired
+		drift = append(drift, "apiAccessListRequired")
+	}
+	if differs(want.MultiFactorAuthRequired, got.MultiFactorAuthRequired) {
+		patch.MultiFactorAuthRequired = want.MultiFactorAuthRequired
+		drift = append(drift, "multiFactorAuthRequired")
+	}
+	if differs(want.RestrictEmployeeAccess, got.RestrictEmployeeAccess) {
+		patch.RestrictEmployeeAccess = want.RestrictEmployeeAccess
+		drift = append(drift, "restrictEmployeeAccess")
+	}
+	if differs(want.GenAIFeaturesEnabled, got.GenAIFeaturesEnabled) {
+		patch.GenAIFeaturesEnabled = want.GenAIFeaturesEnabled
+		drift = append(drift, "genAIFeaturesEnabled")
+	}
+	if differs(want.SecurityContact, got.SecurityContact) {
+		patch.SecurityContact = want.SecurityContact
+		drift = append(drift, "securityContact")
+	}
+	return patch, drift, nil
+}
+
+// updateSettings patches the security settings of an organization that
+// differ from its spec.
+func (c *external) updateSettings(ctx context.Context, cr *v1alpha1.Organization) error {
+	orgID := meta.GetExternalName(cr)
+	patch, drift, err := c.observeSettings(ctx, cr, orgID)
+	if err != nil || len(drift) == 0 {
+		return err
+	}
+	err = c.withOrgClient(ctx, cr, func(client svc.Service) error {
+		_, err := client.UpdateOrganizationSettings(ctx, orgID, patch)
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, errUpdateSettings)
+	}
+	c.recorder.Event(cr, event.Normal(reasonSettingsUpdated, "Updated organization settings: "+strings.Join(drift, ", ")))
+	return nil
+}
+
+// apiKeyID returns the ID of the API key created with an organization.
+// Organizations created before the ID was recorded read it from their secret.
+func (c *external) apiKeyID(ctx context.Context, cr *v1alpha1.Organization) (string, error) {
+	if id := cr.Status.AtProvider.APIKeyID; id != "" {
+		return id, nil
+	}
+	creds, err := c.sink.Get(ctx, c.storedSecretName(cr))
+	if err != nil {
+		return "", errors.Wrap(err, errGetAPIKeyID)
+	}
+	if creds.APIKeyID == "" {
+		return "", errors.New(errNoAPIKeyID)
+	}
+	cr.Status.AtProvider.APIKeyID = creds.APIKeyID
+	return creds.APIKeyID, nil
+}
+
+// observeAccessList records the access list of the API key of an
+// organization and returns the CIDR blocks to add and remove to match its
+// spec.
+func (c *external) observeAccessList(ctx context.Context, cr *v1alpha1.Organization, orgID string) ([]string, []string, error) {
+	var want []string
+	if apiKey := cr.Spec.ForProvider.APIKey; apiKey != nil {
+		want = apiKey.AccessList
+	}
+	if len(want) == 0 {
+		cr.Status.AtProvider.APIKeyAccessList = nil
+		return nil, nil, nil
+	}
+	keyID, err := c.apiKeyID(ctx, cr)
+	if err != nil {
+		return nil, nil, err
+	}
+	var got []string
+	err = c.withOrgClient(ctx, cr, func(client svc.Service) error {
+		var err error
+		got, err = client.ListAPIKeyAccessList(ctx, orgID, keyID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGetAccessList)
+	}
+	cr.Status.AtProvider.APIKeyAccessList = got
+	add, remove := diffCIDRBlocks(want, got)
+	return add, remove, nil
+}
+
+// updateAccessList reconciles the access list of the API key of an
+// organization. Entries are added before others are removed, so that the
+// provider keeps access while the list is replaced.
+func (c *external) updateAccessList(ctx context.Context, cr *v1alpha1.Organization) error {
+	orgID := meta.GetExternalName(cr)
+	add, remove, err := c.observeAccessList(ctx, cr, orgID)
+	if err != nil || len(add) == 0 && len(remove) == 0 {
+		return err
+	}
+	keyID := cr.Status.AtProvider.APIKeyID
+	err = c.withOrgClient(ctx, cr, func(client svc.Service) error {
+		if len(add) > 0 {
+			if err := client.AddAPIKeyAccessList(ctx, orgID, keyID, add); err != nil {
+				return err
+			}
+		}
+		for _, b := range remove {
+			if err := client.DeleteAPIKeyAccessListEntry(ctx, orgID, keyID, b); err != nil && !svc.IsNotFoundError(err) {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, errUpdateAccessList)
+	}
+	c.recorder.Event(cr, event.Normal(reasonAccessListUpdated, fmt.Sprintf("Updated API key access list: added %d, removed %d entries", len(add), len(remove))))
+	return nil
+}
+
+// diffCIDRBlocks returns the CIDR blocks to add to and remove from an access
+// list to match the wanted one.
+func diffCIDRBlocks(want, got []string) ([]string, []string) {
+	wanted := map[string]bool{}
+	for _, b := range want {
+		wanted[canonicalCIDR(b)] = true
+	}
+	present := map[string]bool{}
+	var add, remove []string
+	for _, b := range got {
+		c := canonicalCIDR(b)
+		present[c] = true
+		if !wanted[c] {
+			remove = append(remove, b)
+		}
+	}
+	for _, b := range want {
+		c := canonicalCIDR(b)
+		if !present[c] {
+			add = append(add, c)
+			present[c] = true
+		}
+	}
+	return add, remove
+}
+
+// canonicalCIDR returns an IP address or CIDR block in the form Atlas reports
+// it, e.g. 10.0.0.1/32.
+func canonicalCIDR(s string) string {
+	if ip := net.ParseIP(s); ip != nil {
+		if ip.To4() != nil {
+			return ip.String() + "/32"
+		}
+		return ip.String() + "/128"
+	}
+	if _, n, err := net.ParseCIDR(s); err == nil {
+		return n.String()
+	}
+	return s
+}
+
+// differs reports whether a desired value is set and differs from the
+// observed one.
+func differs[T comparable](want, got *T) bool {
+	return want != nil && (got == nil || *want != *got)
+}
+
+// observeDeletion reports the organization as existing until Atlas confirms
+// that it is gone. Only then the secret holding its API key is deleted and the
+// cleanup finalizer released, so that an organization with the same name can
+// be created again right away.
+func (c *external) observeDeletion(ctx context.Context, cr *v1alpha1.Organization, orgID string) (managed.ExternalObservation, error) {
+	c.setState(cr, v1alpha1.OrganizationStateDeleting)
+	cr.SetConditions(xpv1.Deleting())
+
+	// Deletion was not requested from Atlas yet.
+	if cr.Status.AtProvider.DeletedAt == nil {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	err := c.withOrgClient(ctx, cr, func(client svc.Service) error {
+		err := client.VerifyOrganizationDeletion(ctx, orgID)
+		// API keys are deleted with their organization, so Atlas rejects the
+		// key of a deleted organization instead of reporting it missing.
+		if client != c.client && svc.IsUnauthorizedError(err) {
+			return nil
+		}
+		return err
+	})
+	if svc.IsOrganizationExistsError(err) {
+		c.logger.Debug("Organization deletion not yet complete",
+			"orgID", orgID,
+			"deletedAt", cr.Status.AtProvider.DeletedAt,
+		)
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errVerifyDeletion)
+	}
+
+	if err := c.cleanupSecret(ctx, cr); err != nil && !awsclient.IsNotFound(err) && !vault.IsNotFound(err) {
+		return managed.ExternalObservation{}, err
+	}
+
+	// Updating the object replaces its status with the stored one.
+	status := cr.Status.DeepCopy()
+	if err := c.finalizer.RemoveFinalizer(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRemoveFinalizer)
+	}
+	cr.Status = *status
+	c.setState(cr, v1alpha1.OrganizationStateDeleted)
+	return managed.ExternalObservation{ResourceExists: false}, nil
+}
+
+// syncFinalizer adds the cleanup finalizer to an organization that is not
+// being deleted if deleting the resource deletes the organization in Atlas,
+// and removes it otherwise. The managed reconciler never observes orphaned
+// organizations that are being deleted, so they must not hold it.
+func (c *external) syncFinalizer(ctx context.Context, cr *v1alpha1.Organization) error {
+	// Updating the object replaces its status with the stored one.
+	status := cr.Status.DeepCopy()
+	if managed.NewManagementPoliciesResolver(c.managementPolicies, cr.GetManagementPolicies(), cr.GetDeletionPolicy()).ShouldDelete() {
+		if err := c.finalizer.AddFinalizer(ctx, cr); err != nil {
+			return errors.Wrap(err, errAddFinalizer)
+		}
+	} else if err := c.finalizer.RemoveFinalizer(ctx, cr); err != nil {
+		return errors.Wrap(err, errRemoveFinalizer)
+	}
+	cr.Status = *status
+	return nil
+}
+
+// cleanupSecret applies the secret deletion policy to the secret of a deleted
+// organization. Retained secrets are tagged as orphaned.
+func (c *external) cleanupSecret(ctx context.Context, cr *v1alpha1.Organization) error {
+	secretName := c.storedSecretName(cr)
+	if usesVault(cr) {
+		switch cr.Spec.ForProvider.VaultConfig.SecretDeletionPolicy {
+		case v1alpha1.SecretDeletionPolicyRetain:
+			c.logger.Debug("Retaining Vault secret of deleted organization", "path", secretName)
+			return nil
+		case v1alpha1.SecretDeletionPolicyForceDelete:
+			return errors.Wrap(c.sink.Delete(ctx, secretName, true), errDeleteSecret)
+		default:
+			return errors.Wrap(c.sink.Delete(ctx, secretName, false), errDeleteSecret)
+		}
+	}
+
+	cfg := cr.Spec.ForProvider.AWSSecretsConfig
+
+	switch cfg.SecretDeletionPolicy {
+	case v1alpha1.SecretDeletionPolicyRetain:
+		c.logger.Debug("Retaining secret of deleted organization", "secretName", secretName)
+		deletedAt := metav1.Now()
+		if ts := cr.GetDeletionTimestamp(); ts != nil {
+			deletedAt = *ts
+		}
+		return errors.Wrap(c.awsClient.TagSecret(ctx, secretName, map[string]string{
+			awsclient.TagOrphaned:   "true",
+			awsclient.TagOrphanedAt: deletedAt.UTC().Format(time.RFC3339),
+		}), errTagSecret)
+	case v1alpha1.SecretDeletionPolicyForceDelete:
+		if err := c.removeReplicas(ctx, secretName); err != nil {
+			return err
+		}
+		c.logger.Debug("Force deleting secret of deleted organization", "secretName", secretName)
+		return errors.Wrap(c.awsClient.DeleteSecret(ctx, secretName, true, 0), errDeleteSecret)
+	default:
+		if err := c.removeReplicas(ctx, secretName); err != nil {
+			return err
+		}
+		window := pointer.Int64Deref(cfg.RecoveryWindowInDays, awsclient.DefaultRecoveryWindowInDays)
+		c.logger.Debug("Scheduling deletion of secret of deleted organization", "secretName", secretName, "recoveryWindowInDays", window)
+		return errors.Wrap(c.awsClient.DeleteSecret(ctx, secretName, false, window), errDeleteSecret)
+	}
+}
+
+// moveSecret moves the secret of an organization to a new name after the
+// secret naming of its ProviderConfig changed. The old secret is deleted
+// without a recovery window once it was copied, so that the name can be used
+// again right away, e.g. to move the secret back.
+func (c *external) moveSecret(ctx context.Context, cr *v1alpha1.Organization, from, to string) error {
+	var arn string
+	desc, err := c.awsClient.DescribeSecret(ctx, from)
+	switch {
+	case awsclient.IsNotFound(err) || (err == nil && desc.DeletedDate != nil):
+		// The secret was moved, but the new name was not recorded.
+		desc, err := c.awsClient.DescribeSecret(ctx, to)
+		if err != nil {
+			return errors.Wrap(err, errMoveSecret)
+		}
+		arn = aws.ToString(desc.ARN)
+	case err != nil:
+		return errors.Wrap(err, errMoveSecret)
+	default:
+		c.logger.Debug("Moving secret", "from", from, "to", to)
+		arn, err = c.awsClient.CopySecret(ctx, from, to, meta.GetExternalName(cr), secretOptions(cr))
+		if err != nil {
+			return errors.Wrap(err, errMoveSecret)
+		}
+		if err := c.removeReplicas(ctx, from); err != nil {
+			return errors.Wrap(err, errMoveSecret)
+		}
+		if err := c.awsClient.DeleteSecret(ctx, from, true, 0); err != nil {
+			return errors.Wrap(err, errMoveSecret)
+		}
+	}
+
+	cr.Status.AtProvider.SecretName = to
+	cr.Status.AtProvider.SecretARN = arn
+	c.recorder.Event(cr, event.Normal(reasonSecretMoved, fmt.Sprintf("Moved secret %s to %s", from, to)))
+	return nil
+}
+
+// removeReplicas deletes all replicas of a secret. Secrets Manager refuses to
+// delete a secret that is still replicated.
+func (c *external) removeReplicas(ctx context.Context, secretName string) error {
+	desc, err := c.awsClient.DescribeSecret(ctx, secretName)
+	if err != nil {
+		return errors.Wrap(err, errDescribeSecret)
+	}
+	regions := make([]string, 0, len(desc.ReplicationStatus))
+	for _, r := range desc.ReplicationStatus {
+		regions = append(regions, aws.ToString(r.Region))
+	}
+	if len(regions) == 0 {
+		return nil
+	}
+	c.logger.Debug("Removing secret replicas before deletion", "secretName", secretName, "regions", regions)
+	return c.awsClient.RemoveReplicaRegions(ctx, secretName, regions)
+}
+
+// checkChildProjects blocks deletion while the organization still contains
+// projects, unless its child deletion policy allows deleting them first.
+func (c *external) checkChildProjects(ctx context.Context, cr *v1alpha1.Organization, orgID string) error {
+	var projects []svc.Project
+	err := c.withOrgClient(ctx, cr, func(client svc.Service) error {
+		var err error
+		projects, err = client.ListOrganizationProjects(ctx, orgID)
+		return err
+	})
+	if svc.IsNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errListProjects)
+	}
+
+	if len(projects) > 0 && cr.Spec.ForProvider.ChildDeletionPolicy == v1alpha1.ChildDeletionPolicyCascade {
+		for _, p := range projects {
+			c.logger.Debug("Deleting child project before organization", "orgID", orgID, "projectID", p.ID)
+			err := c.withOrgClient(ctx, cr, func(client svc.Service) error {
+				return client.DeleteProject(ctx, p.ID)
+			})
+			if err != nil && !svc.IsNotFoundError(err) {
+				return errors.Wrapf(err, errFmtDeleteProject, p.Name)
+			}
+		}
+		projects = nil
+	}
+
+	if len(projects) > 0 {
+		names := make([]string, 0, len(projects))
+		for _, p := range projects {
+			names = append(names, fmt.Sprintf("%s (%s)", p.Name, p.ID))
+		}
+		cr.SetConditions(v1alpha1.DeletionBlocked(names))
+		return errors.Errorf(errFmtDeletionBlocked, strings.Join(names, ", "))
+	}
+
+	if cr.GetCondition(v1alpha1.TypeDeletionBlocked).Status == corev1.ConditionTrue {
+		cr.SetConditions(v1alpha1.DeletionUnblocked())
+	}
+	return nil
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr := mg.(*v1alpha1.Organization)
 
+	// The cleanup finalizer must be in place before the organization exists
+	// in Atlas, since the object may be deleted before it is observed again.
+	if err := c.syncFinalizer(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// The private key of the organization API key is only returned once. Make
+	// sure it can be stored before creating the organization.
+	var keyARN string
+	if keyID := cr.Spec.ForProvider.AWSSecretsConfig.KMSKeyID; keyID != nil && !usesVault(cr) {
+		arn, err := c.awsClient.ValidateKey(ctx, *keyID)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errValidateKMSKey)
+		}
+		keyARN = arn
+	}
+
+	// Resume an earlier creation whose API key could not be written to the
+	// credential sink.
+	creds, err := c.escrow.Get(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetEscrow)
+	}
+	escrowed := true
+	if creds != nil {
+		c.recorder.Event(cr, event.Normal(reasonCreateResumed, fmt.Sprintf("Resuming creation of organization %s from escrow", creds.OrgID)))
+	} else {
+		creds, escrowed, err = c.createOrganization(ctx, cr)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	meta.SetExternalName(cr, creds.OrgID)
+	tracing.AnnotateResource(ctx, cr)
+	cr.Status.AtProvider.OrgID = creds.OrgID
+	cr.Status.AtProvider.OrgName = cr.Name
+	if createdAt, err := time.Parse(time.RFC3339, creds.CreatedAt); err == nil {
+		cr.Status.AtProvider.CreatedAt = &metav1.Time{Time: createdAt}
+	}
+	if !usesVault(cr) {
+		cr.Status.AtProvider.KMSKeyID = keyARN
+	}
+	c.setState(cr, v1alpha1.OrganizationStatePending)
+
+	// The external name is recorded even if storing the API key fails, so
+	// that the organization is not created again.
+	details, err := c.storeCredentials(ctx, cr, *creds)
+	if err != nil && !escrowed {
+		return managed.ExternalCreation{}, errors.Wrapf(err, errFmtKeyNotPersisted, creds.OrgID)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{ConnectionDetails: details}, nil
+}
+
+// createOrganization creates an organization in Atlas and escrows its API key.
+// It refuses to create a second organization with the same name, unless the
+// API key of the existing one was already stored by an interrupted creation.
+// It reports whether the API key is escrowed or already stored.
+func (c *external) createOrganization(ctx context.Context, cr *v1alpha1.Organization) (*awsclient.MongoDBAPICredentials, bool, error) {
+	existing, err := c.client.FindOrganizationsByName(ctx, cr.Name)
+	if svc.IsUnauthorizedError(err) {
+		// The provider may not be allowed to list organizations. Creation
+		// proceeds, but duplicates cannot be detected.
+		c.logger.Debug(errFindOrganization, "name", cr.Name, "error", err)
+		c.recorder.Event(cr, event.Warning(reasonNameCheckSkipped, errors.Wrap(err, errFindOrganization)))
+	} else if err != nil {
+		return nil, false, errors.Wrap(err, errFindOrganization)
+	}
+	for _, org := range existing {
+		if org.IsDeleted {
+			continue
+		}
+		if stored, err := c.sink.Get(ctx, c.secretName); err == nil && stored.OrgID == org.ID {
+			c.logger.Debug("Adopting organization with stored API key", "name", cr.Name, "orgID", org.ID)
+			return stored, true, nil
+		}
+		return nil, false, errors.Errorf(errFmtOrganizationExists, cr.Name, org.ID)
+	}
+
+	// The secret of a new organization must not replace one retained for a
+	// deleted organization. Check before Atlas is called.
+	if !usesVault(cr) {
+		desc, err := c.awsClient.DescribeSecret(ctx, c.secretName)
+		if err != nil && !awsclient.IsNotFound(err) {
+			return nil, false, errors.Wrap(err, errDescribeSecret)
+		}
+		if err == nil {
+			if orgID, orphaned := awsclient.OrphanedOrgID(desc); orphaned {
+				return nil, false, errors.Errorf(errFmtSecretOrphaned, c.secretName, orgID)
+			}
+		}
+	}
+
+	params := cr.Spec.ForProvider.APIKey
+	if params == nil {
+		return nil, false, errors.New(errNoAPIKey)
+	}
 	org, apiKey, err := c.client.CreateOrganization(ctx, svc.CreateOrganizationInput{
 		Name:    cr.Name,
 		OwnerID: cr.Spec.ForProvider.OwnerID,
 		APIKey: svc.APIKey{
-			Description: cr.Spec.ForProvider.APIKey.Description,
-			Roles:       cr.Spec.ForProvider.APIKey.Roles,
+			Description: params.Description,
+			Roles:       params.Roles,
 		},
 	})
 	if err != nil {
-		return managed.ExternalCreation{}, err
+		return nil, false, err
 	}
-
-	meta.SetExternalName(cr, org.ID)
-	cr.Status.AtProvider.OrgID = org.ID
-	cr.Status.AtProvider.OrgName = org.Name
-
-	secretName := finalSecretName(cr)
-	creds := awsclient.MongoDBAPICredentials{
+	creds := &awsclient.MongoDBAPICredentials{
 		PublicKey:  apiKey.PublicKey,
 		PrivateKey: apiKey.PrivateKey,
+		OrgID:      org.ID,
+		APIKeyID:   apiKey.ID,
+		Roles:      params.Roles,
+		BaseURL:    svc.BaseURL,
+		CreatedAt:  time.Now().UTC().Format(time.RFC3339),
 	}
-	arn, err := c.awsClient.PutSecret(ctx, secretName, creds, org.ID, cr.Spec.ForProvider.AWSSecretsConfig.KMSKeyID)
+	if err := c.escrow.Put(ctx, cr, *creds); err != nil {
+		// Still try to store the API key, it is lost if that fails too.
+		c.recorder.Event(cr, event.Warning(reasonEscrowFailed, errors.Wrap(err, errPutEscrow)))
+		return creds, false, nil
+	}
+	return creds, true, nil
+}
+
+// storeCredentials writes the API key of an organization to the credential
+// sink and releases its escrow. It returns the connection details of the
+// organization.
+func (c *external) storeCredentials(ctx context.Context, cr *v1alpha1.Organization, creds awsclient.MongoDBAPICredentials) (managed.ConnectionDetails, error) {
+	secretName := c.secretName
+	ref, err := c.sink.Put(ctx, secretName, creds)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, "failed to put secret")
+		return nil, errors.Wrap(err, "failed to put secret")
 	}
 	cr.Status.AtProvider.SecretName = secretName
-	cr.Status.AtProvider.SecretARN = arn
+	cr.Status.AtProvider.APIKeyID = creds.APIKeyID
 
-	return managed.ExternalCreation{
-		ConnectionDetails: managed.ConnectionDetails{
-			"publicKey":  []byte(apiKey.PublicKey),
-			"privateKey": []byte(apiKey.PrivateKey),
-			"secretARN":  []byte(arn),
-		},
-	}, nil
+	details := managed.ConnectionDetails{
+		"publicKey":  []byte(creds.PublicKey),
+		"privateKey": []byte(creds.PrivateKey),
+	}
+	if !usesVault(cr) {
+		cr.Status.AtProvider.SecretARN = ref
+		details["secretARN"] = []byte(ref)
+	}
+	if err := c.escrow.Delete(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errDeleteEscrow)
+	}
+	return details, nil
 }
 
+// Update reconciles the settings of the organization, the access list of its
+// API key and the name, tags, resource policy and replicas of the secret
+// holding the key.
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr := mg.(*v1alpha1.Organization)
+
+	// Finish a creation that failed to store the API key.
+	creds, err := c.escrow.Get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetEscrow)
+	}
+	if creds != nil {
+		details, err := c.storeCredentials(ctx, cr, *creds)
+		return managed.ExternalUpdate{ConnectionDetails: details}, err
+	}
+
+	if err := c.updateSettings(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := c.updateAccessList(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if usesVault(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+	secretName := c.secretName
+
+	if stored := cr.Status.AtProvider.SecretName; stored != "" && stored != secretName {
+		if err := c.moveSecret(ctx, cr, stored, secretName); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	desc, err := c.awsClient.DescribeSecret(ctx, secretName)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeSecret)
+	}
+	set, remove := awsclient.DiffTags(desc.Tags, secretTags(cr))
+	if len(set) > 0 {
+		c.logger.Debug("Tagging secret", "secretName", secretName, "tags", set)
+		if err := c.awsClient.TagSecret(ctx, secretName, set); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if len(remove) > 0 {
+		c.logger.Debug("Untagging secret", "secretName", secretName, "keys", remove)
+		if err := c.awsClient.UntagSecret(ctx, secretName, remove); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	policy, removePolicy, err := c.diffResourcePolicy(ctx, cr, secretName)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if policy != nil {
+		c.logger.Debug("Putting secret resource policy", "secretName", secretName)
+		if err := c.awsClient.PutResourcePolicy(ctx, secretName, *policy); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if removePolicy {
+		c.logger.Debug("Deleting secret resource policy", "secretName", secretName)
+		if err := c.awsClient.DeleteResourcePolicy(ctx, secretName); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	add, drop := awsclient.DiffReplicaRegions(desc.ReplicationStatus, replicaRegions(cr))
+	if len(drop) > 0 {
+		c.logger.Debug("Removing secret replicas", "secretName", secretName, "regions", drop)
+		if err := c.awsClient.RemoveReplicaRegions(ctx, secretName, drop); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if len(add) > 0 {
+		c.logger.Debug("Replicating secret", "secretName", secretName, "regions", len(add))
+		if err := c.awsClient.ReplicateSecret(ctx, secretName, add); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
-// ADD: Enhanced Delete with finalizer management and error handling
+// Delete requests deletion of the organization from Atlas and records when it
+// did so. Observe then waits for the deletion to complete before the secret is
+// deleted and the cleanup finalizer released.
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr := mg.(*v1alpha1.Organization)
 	orgID := meta.GetExternalName(cr)
@@ -225,94 +1277,39 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return nil
 	}
 
-	// Debug: Starting delete workflow
-	c.logger.Debug("Delete() invoked for Organization",
-		"orgID", orgID,
-		"name", cr.Name,
-		"finalizerPresent", meta.FinalizerExists(cr, FinalizerOrganizationCleanup),
-	)
-
-	// ============================================================
-	// 1. Add finalizer if missing
-	// ============================================================
-	if !meta.FinalizerExists(cr, FinalizerOrganizationCleanup) {
-		c.logger.Debug("Finalizer missing — adding finalizer before delete", 
+	if cr.Status.AtProvider.DeletedAt != nil {
+		c.logger.Debug("Organization deletion already requested, waiting for Atlas",
 			"orgID", orgID,
+			"deletedAt", cr.Status.AtProvider.DeletedAt,
 		)
-		meta.AddFinalizer(cr, FinalizerOrganizationCleanup)
 		return nil
 	}
 
-	// ============================================================
-	// 2. DEBUG: SHOW EXACT MONGODB ATLAS API CALL BEING TRIGGERED
-	// ============================================================
+	if err := c.checkChildProjects(ctx, cr, orgID); err != nil {
+		return err
+	}
+	if err := c.escrow.Delete(ctx, cr); err != nil {
+		return errors.Wrap(err, errDeleteEscrow)
+	}
+
+	c.setState(cr, v1alpha1.OrganizationStateDeleting)
 	c.logger.Debug("Calling MongoDB Atlas API → DeleteOrganization()",
 		"api", "DELETE /api/atlas/v2/orgs/{orgId}",
 		"orgID", orgID,
 	)
 
-	err := c.client.DeleteOrganization(ctx, orgID)
-
-	// 404 means already deleted
-	if svc.IsNotFoundError(err) {
-		c.logger.Debug("MongoDB Atlas API returned 404 — org already deleted",
-			"orgID", orgID,
-		)
-
-		// ============================================================
-		// AWS Secret Delete Debug
-		// ============================================================
-		secretName := finalSecretName(cr)
-		c.logger.Debug("Secret cleanup step: calling AWS DeleteSecret()",
-			"secretName", secretName,
-			"awsAPI", "DeleteSecret",
-		)
-		if delErr := c.awsClient.DeleteSecret(ctx, secretName, true); delErr != nil {
-			c.logger.Debug("AWS DeleteSecret failed",
-				"secretName", secretName,
-				"error", delErr,
-			)
-		}
-
-		meta.RemoveFinalizer(cr, FinalizerOrganizationCleanup)
-		cr.SetConditions(xpv1.ReconcileSuccess())
-		return nil
-	}
-
-	// Handle other failures
-	if err != nil {
+	err := c.withOrgClient(ctx, cr, func(client svc.Service) error {
+		return client.DeleteOrganization(ctx, orgID)
+	})
+	if err != nil && !svc.IsNotFoundError(err) {
 		c.logger.Debug("Atlas DeleteOrganization() returned error",
 			"orgID", orgID,
 			"error", err,
 		)
-		cr.SetConditions(xpv1.ReconcileError(err))
 		return errors.Wrap(err, errDeleteExternal)
 	}
 
-	// ============================================================
-	// 3. SUCCESS — remove finalizer
-	// ============================================================
-	c.logger.Debug("MongoDB Atlas organization deleted successfully",
-		"orgID", orgID,
-	)
-
-	// ============================================================
-	// AWS Secret Delete Debug
-	// ============================================================
-	secretName := finalSecretName(cr)
-	c.logger.Debug("Secret cleanup step: calling AWS DeleteSecret()",
-		"secretName", secretName,
-		"awsAPI", "DeleteSecret",
-	)
-
-	if delErr := c.awsClient.DeleteSecret(ctx, secretName, true); delErr != nil {
-		c.logger.Debug("AWS DeleteSecret failed",
-			"secretName", secretName,
-			"error", delErr,
-		)
-	}
-
-	meta.RemoveFinalizer(cr, FinalizerOrganizationCleanup)
-	cr.SetConditions(xpv1.ReconcileSuccess())
+	now := metav1.Now()
+	cr.Status.AtProvider.DeletedAt = &now
 	return nil
 }
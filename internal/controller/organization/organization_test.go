@@ -0,0 +1,1208 @@
+package organization
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	kubefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	apisv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws/fake"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/sink"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/vault"
+	fakevault "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/vault/fake"
+)
+
+const testSecretName = "product/mongodb/test-org"
+
+// mockService is an Atlas service that creates organizations and reports
+// them as deleted.
+type mockService struct {
+	svc.Service
+	createErr error
+	findErr   error
+	verifyErr error
+	existing  []svc.Organization
+	settings  svc.OrganizationSettings
+	projects  []svc.Project
+	// unauthorized rejects requests to the organization, like the API key
+	// of the parent organization of the provider.
+	unauthorized bool
+
+	creates         int
+	deletedProjects []string
+	deletedOrg      bool
+}
+
+func (m *mockService) GetOrganizationSettings(_ context.Context, _ string) (*svc.OrganizationSettings, error) {
+	return &m.settings, nil
+}
+
+func (m *mockService) GetOrganization(_ context.Context, id string) (*svc.Organization, error) {
+	return &svc.Organization{ID: id, Name: "test-org"}, nil
+}
+
+func (m *mockService) FindOrganizationsByName(_ context.Context, _ string) ([]svc.Organization, error) {
+	return m.existing, m.findErr
+}
+
+func (m *mockService) CreateOrganization(_ context.Context, input svc.CreateOrganizationInput) (*svc.Organization, svc.APIKeyPair, error) {
+	m.creates++
+	if m.createErr != nil {
+		return nil, svc.APIKeyPair{}, m.createErr
+	}
+	return &svc.Organization{ID: "orgID123", Name: input.Name}, svc.APIKeyPair{ID: "keyID123", PublicKey: "public", PrivateKey: "private"}, nil
+}
+
+func (m *mockService) VerifyOrganizationDeletion(_ context.Context, _ string) error {
+	if m.unauthorized {
+		return svc.Error{Code: http.StatusUnauthorized}
+	}
+	return m.verifyErr
+}
+
+func (m *mockService) ListOrganizationProjects(_ context.Context, _ string) ([]svc.Project, error) {
+	if m.unauthorized {
+		return nil, svc.Error{Code: http.StatusUnauthorized}
+	}
+	var projects []svc.Project
+	for _, p := range m.projects {
+		if !slices.Contains(m.deletedProjects, p.ID) {
+			projects = append(projects, p)
+		}
+	}
+	return projects, nil
+}
+
+func (m *mockService) DeleteProject(_ context.Context, id string) error {
+	if m.unauthorized {
+		return svc.Error{Code: http.StatusUnauthorized}
+	}
+	m.deletedProjects = append(m.deletedProjects, id)
+	return nil
+}
+
+func (m *mockService) DeleteOrganization(_ context.Context, _ string) error {
+	if m.unauthorized {
+		return svc.Error{Code: http.StatusUnauthorized}
+	}
+	// Atlas rejects the deletion of an organization that still has projects.
+	if len(m.projects) > len(m.deletedProjects) {
+		return svc.Error{Code: 409, Reason: "CANNOT_CLOSE_GROUP_ACTIVE_ATLAS_CLUSTERS"}
+	}
+	m.deletedOrg = true
+	return nil
+}
+
+// warnings records the reasons of warning events.
+type warnings struct {
+	reasons []event.Reason
+}
+
+func (w *warnings) Event(_ runtime.Object, e event.Event) {
+	if e.Type == event.TypeWarning {
+		w.reasons = append(w.reasons, e.Reason)
+	}
+}
+
+func (w *warnings) WithAnnotations(_ ...string) event.Recorder {
+	return w
+}
+
+func newExternal(service svc.Service, store *fake.SecretStore) *external {
+	return &external{
+		client:     service,
+		logger:     logging.NewNopLogger(),
+		recorder:   event.NewNopRecorder(),
+		finalizer:  resource.NewNopFinalizer(),
+		awsClient:  store,
+		sink:       &sink.SecretsManager{Client: store},
+		escrow:     escrow{kube: kubefake.NewClientBuilder().Build(), namespace: defaultEscrowNamespace},
+		secretName: testSecretName,
+	}
+}
+
+// newOrgKeyExternal returns an external client whose provider key is not
+// authorized for the organization. Requests fall back to service with the API
+// key of the organization, which must be in store.
+func newOrgKeyExternal(service *mockService, store *fake.SecretStore) *external {
+	e := newExternal(&mockService{unauthorized: true}, store)
+	e.newServiceFn = func(_ svc.Credentials) svc.Service { return service }
+	return e
+}
+
+func TestConnect(t *testing.T) {
+	providerCreds := awsclient.MongoDBAPICredentials{PublicKey: "pc-public", PrivateKey: "pc-private"}
+	awsSource := &apisv1alpha1.AWSCredentialsSource{SecretsManager: &apisv1alpha1.AWSSecretsManagerReference{
+		Region:     "eu-central-1",
+		SecretName: pointer.String("provider/atlas"),
+	}}
+	secretSource := xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "atlas"},
+		Key:             "credentials",
+	}}
+
+	tests := []struct {
+		name        string
+		credentials apisv1alpha1.ProviderCredentials
+		vaultSink   bool
+		wantErr     bool
+	}{
+		{
+			name:        "AWS",
+			credentials: apisv1alpha1.ProviderCredentials{Source: apisv1alpha1.CredentialsSourceAWS, AWS: awsSource},
+		},
+		{
+			name:        "AWSWithVaultSink",
+			credentials: apisv1alpha1.ProviderCredentials{Source: apisv1alpha1.CredentialsSourceAWS, AWS: awsSource},
+			vaultSink:   true,
+		},
+		{
+			name:        "SecretWithVaultSink",
+			credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, CommonCredentialSelectors: secretSource},
+			vaultSink:   true,
+		},
+		{
+			name: "VaultWithVaultSink",
+			credentials: apisv1alpha1.ProviderCredentials{
+				Source: apisv1alpha1.CredentialsSourceVault,
+				Vault:  &apisv1alpha1.VaultCredentialsSource{StoreConfigRef: xpv1.Reference{Name: "vault"}, Path: "provider/atlas"},
+			},
+			vaultSink: true,
+		},
+		{
+			// The API key of the organization is written to AWS Secrets
+			// Manager, which the ProviderConfig does not configure.
+			name:        "SecretWithSecretsManagerSink",
+			credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, CommonCredentialSelectors: secretSource},
+			wantErr:     true,
+		},
+		{
+			name:        "UnsupportedSource",
+			credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceEnvironment},
+			vaultSink:   true,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, server, kube := newConnectEnv(t, tt.credentials, providerCreds)
+			defer server.Close()
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org"}}
+			cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+			if tt.vaultSink {
+				cr.Spec.ForProvider.CredentialSink = v1alpha1.CredentialSinkVault
+				cr.Spec.ForProvider.VaultConfig = &v1alpha1.VaultReference{StoreConfigRef: xpv1.Reference{Name: "vault"}}
+			}
+			store := fake.NewSecretStore()
+			if _, err := store.PutSecret(context.Background(), "provider/atlas", providerCreds, awsclient.PayloadFormat{}, awsclient.SecretOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			var got svc.Credentials
+			c := &connector{
+				kube:           kube,
+				logger:         logging.NewNopLogger(),
+				recorder:       event.NewNopRecorder(),
+				newAWSClientFn: func(_ context.Context, _ string) (awsclient.SecretStore, error) { return store, nil },
+				newServiceFn: func(creds svc.Credentials) svc.Service {
+					got = creds
+					return &mockService{}
+				},
+			}
+
+			e, err := c.connect(context.Background(), cr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("connect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := svc.Credentials{PublicKey: providerCreds.PublicKey, PrivateKey: providerCreds.PrivateKey}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("connect() credentials -want, +got:\n%s", diff)
+			}
+			if _, ok := e.sink.(*vault.Client); ok != tt.vaultSink {
+				t.Errorf("connect() sink = %T, vault sink %v", e.sink, tt.vaultSink)
+			}
+		})
+	}
+}
+
+func TestCreateVaultSink(t *testing.T) {
+	credentials := apisv1alpha1.ProviderCredentials{
+		Source: apisv1alpha1.CredentialsSourceVault,
+		Vault:  &apisv1alpha1.VaultCredentialsSource{StoreConfigRef: xpv1.Reference{Name: "vault"}, Path: "provider/atlas"},
+	}
+	vaultServer, server, kube := newConnectEnv(t, credentials, awsclient.MongoDBAPICredentials{PublicKey: "pc-public", PrivateKey: "pc-private"})
+	defer server.Close()
+
+	cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org", UID: "uid123"}}
+	cr.Spec.ForProvider.APIKey = &v1alpha1.OrganizationAPIKey{Roles: []string{"ORG_OWNER"}}
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	cr.Spec.ForProvider.CredentialSink = v1alpha1.CredentialSinkVault
+	cr.Spec.ForProvider.VaultConfig = &v1alpha1.VaultReference{StoreConfigRef: xpv1.Reference{Name: "vault"}}
+	if err := kube.Create(context.Background(), cr); err != nil {
+		t.Fatal(err)
+	}
+	c := &connector{
+		kube:            kube,
+		logger:          logging.NewNopLogger(),
+		recorder:        event.NewNopRecorder(),
+		newServiceFn:    func(_ svc.Credentials) svc.Service { return &mockService{} },
+		escrowNamespace: defaultEscrowNamespace,
+	}
+	e, err := c.connect(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("connect() error = %v", err)
+	}
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	got, ok := vaultServer.Data[testSecretName]
+	if !ok {
+		t.Fatalf("Create() did not write %s to Vault", testSecretName)
+	}
+	if diff := cmp.Diff("orgID123", got[awsclient.FieldOrgID]); diff != "" {
+		t.Errorf("Create() orgId -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(testSecretName, cr.Status.AtProvider.SecretName); diff != "" {
+		t.Errorf("Create() secretName -want, +got:\n%s", diff)
+	}
+}
+
+// newConnectEnv returns a Vault server holding the credentials of a
+// ProviderConfig and a Kubernetes client with the ProviderConfig, a Vault
+// StoreConfig using token auth and a secret holding the credentials.
+func newConnectEnv(t *testing.T, credentials apisv1alpha1.ProviderCredentials, creds awsclient.MongoDBAPICredentials) (*fakevault.Server, *httptest.Server, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{corev1.AddToScheme, v1alpha1.SchemeBuilder.AddToScheme, apisv1alpha1.SchemeBuilder.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := awsclient.PayloadFormat{}.Marshal(creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vaultServer := fakevault.NewServer()
+	vaultServer.Data["provider/atlas"] = map[string]interface{}{"publicKey": creds.PublicKey, "privateKey": creds.PrivateKey}
+	server := httptest.NewServer(vaultServer)
+
+	pc := &apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	pc.Spec.Credentials = credentials
+	sc := &apisv1alpha1.StoreConfig{ObjectMeta: metav1.ObjectMeta{Name: "vault"}}
+	sc.Spec.Vault = &xpv1.VaultSecretStoreConfig{
+		Server:    server.URL,
+		MountPath: "secret",
+		Auth: xpv1.VaultAuthConfig{
+			Method: xpv1.VaultAuthToken,
+			Token: &xpv1.VaultAuthTokenConfig{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "vault-token"},
+					Key:             "token",
+				}},
+			},
+		},
+	}
+	secrets := []client.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "atlas"},
+			Data:       map[string][]byte{"credentials": data},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "vault-token"},
+			Data:       map[string][]byte{"token": []byte("token")},
+		},
+	}
+	kube := kubefake.NewClientBuilder().WithScheme(scheme).WithObjects(append(secrets, pc, sc)...).Build()
+	return vaultServer, server, kube
+}
+
+func TestCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		name        string
+		service     *mockService
+		kmsKeyID    *string
+		escrowed    *awsclient.MongoDBAPICredentials
+		secret      *fake.Secret
+		errors      map[string]error
+		escrowErr   error
+		noAPIKey    bool
+		wantErr     bool
+		wantErrMsg  string
+		wantCreates int
+		wantSecret  bool
+		wantEscrow  bool
+		wantKeyARN  string
+		wantEvents  []event.Reason
+	}{
+		{
+			name:        "Success",
+			service:     &mockService{},
+			wantSecret:  true,
+			wantCreates: 1,
+		},
+		{
+			name:        "AtlasFailure",
+			service:     &mockService{createErr: errBoom},
+			wantErr:     true,
+			wantCreates: 1,
+		},
+		{
+			name:       "MissingAPIKey",
+			service:    &mockService{},
+			noAPIKey:   true,
+			wantErr:    true,
+			wantErrMsg: errNoAPIKey,
+		},
+		{
+			name:        "PutSecretFailure",
+			service:     &mockService{},
+			errors:      map[string]error{"PutSecret": errBoom},
+			wantErr:     true,
+			wantEscrow:  true,
+			wantCreates: 1,
+		},
+		{
+			// Atlas must not be called when resuming from the escrow.
+			name:       "ResumeFromEscrow",
+			service:    &mockService{},
+			escrowed:   &awsclient.MongoDBAPICredentials{PublicKey: "public", PrivateKey: "private", OrgID: "orgID123"},
+			wantSecret: true,
+		},
+		{
+			name:    "DuplicateName",
+			service: &mockService{existing: []svc.Organization{{ID: "orgID456", Name: "test-org"}}},
+			wantErr: true,
+		},
+		{
+			name:        "DeletedDuplicateName",
+			service:     &mockService{existing: []svc.Organization{{ID: "orgID456", Name: "test-org", IsDeleted: true}}},
+			wantSecret:  true,
+			wantCreates: 1,
+		},
+		{
+			name:        "ResolveKMSKey",
+			service:     &mockService{},
+			kmsKeyID:    pointer.String("key123"),
+			wantSecret:  true,
+			wantKeyARN:  "arn:aws:kms:eu-central-1:123456789012:key/key123",
+			wantCreates: 1,
+		},
+		{
+			// The API key is stored right away if it cannot be escrowed.
+			name:        "EscrowFailure",
+			service:     &mockService{},
+			escrowErr:   errBoom,
+			wantSecret:  true,
+			wantCreates: 1,
+			wantEvents:  []event.Reason{reasonEscrowFailed},
+		},
+		{
+			// The organization is recorded, but its API key is lost.
+			name:        "EscrowAndPutSecretFailure",
+			service:     &mockService{},
+			escrowErr:   errBoom,
+			errors:      map[string]error{"PutSecret": errBoom},
+			wantErr:     true,
+			wantErrMsg:  "could not be persisted",
+			wantCreates: 1,
+			wantEvents:  []event.Reason{reasonEscrowFailed},
+		},
+		{
+			// Creation proceeds if other organizations cannot be listed.
+			name:        "UnauthorizedNameCheck",
+			service:     &mockService{findErr: svc.Error{Code: http.StatusUnauthorized}},
+			wantSecret:  true,
+			wantCreates: 1,
+			wantEvents:  []event.Reason{reasonNameCheckSkipped},
+		},
+		{
+			// The secret of an organization deleted before is restored.
+			name:        "SecretScheduledForDeletion",
+			service:     &mockService{},
+			secret:      &fake.Secret{Tags: awsclient.DefaultTags("orgID456"), DeletedDate: &time.Time{}},
+			wantSecret:  true,
+			wantCreates: 1,
+		},
+		{
+			// Atlas must not be called when the secret was retained for
+			// another organization.
+			name:    "OrphanedSecret",
+			service: &mockService{},
+			secret: &fake.Secret{Tags: map[string]string{
+				"OrgID":               "orgID456",
+				awsclient.TagOrphaned: "true",
+			}},
+			wantErr: true,
+		},
+		{
+			// Atlas must not be called when the key is unusable.
+			name:     "UnusableKMSKey",
+			service:  &mockService{},
+			kmsKeyID: pointer.String("key123"),
+			errors:   map[string]error{"ValidateKey": errBoom},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := fake.NewSecretStore()
+			for k, v := range tt.errors {
+				store.Errors[k] = v
+			}
+			if tt.secret != nil {
+				store.Secrets[testSecretName] = tt.secret
+			}
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org", UID: "uid123"}}
+			if !tt.noAPIKey {
+				cr.Spec.ForProvider.APIKey = &v1alpha1.OrganizationAPIKey{Roles: []string{"ORG_OWNER"}}
+			}
+			cr.Spec.ForProvider.AWSSecretsConfig.KMSKeyID = tt.kmsKeyID
+			e := newExternal(tt.service, store)
+			w := &warnings{}
+			e.recorder = w
+			if tt.escrowErr != nil {
+				e.escrow.kube = interceptor.NewClient(kubefake.NewClientBuilder().Build(), interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+						return tt.escrowErr
+					},
+				})
+			}
+			if tt.escrowed != nil {
+				if err := e.escrow.Put(context.Background(), cr, *tt.escrowed); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := e.Create(context.Background(), cr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("Create() error = %v, want %q", err, tt.wantErrMsg)
+			}
+			if tt.service.creates != tt.wantCreates {
+				t.Errorf("Create() CreateOrganization calls = %d, want %d", tt.service.creates, tt.wantCreates)
+			}
+			if diff := cmp.Diff(tt.wantEvents, w.reasons); diff != "" {
+				t.Errorf("Create() warnings -want, +got:\n%s", diff)
+			}
+			// The external name is recorded once the organization exists.
+			if created := tt.wantCreates > 0 && tt.service.createErr == nil; created && meta.GetExternalName(cr) != "orgID123" {
+				t.Errorf("Create() external name = %q, want orgID123", meta.GetExternalName(cr))
+			}
+			escrowed, err := e.escrow.Get(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (escrowed != nil) != tt.wantEscrow {
+				t.Errorf("Create() escrowed = %v, wantEscrow %v", escrowed != nil, tt.wantEscrow)
+			}
+			_, err = store.GetSecret(context.Background(), testSecretName)
+			if (err == nil) != tt.wantSecret {
+				t.Fatalf("GetSecret() error = %v, wantSecret %v", err, tt.wantSecret)
+			}
+			if !tt.wantSecret {
+				return
+			}
+			if diff := cmp.Diff(testSecretName, cr.Status.AtProvider.SecretName); diff != "" {
+				t.Errorf("Create() secretName -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff("orgID123", meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("Create() external name -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantKeyARN, cr.Status.AtProvider.KMSKeyID); diff != "" {
+				t.Errorf("Create() kmsKeyID -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	projects := []svc.Project{{ID: "projectID1", Name: "one"}, {ID: "projectID2", Name: "two"}}
+
+	tests := []struct {
+		name                string
+		policy              string
+		projects            []svc.Project
+		orgKey              bool
+		wantErr             bool
+		wantBlocked         bool
+		wantDeletedProjects []string
+		wantDeletedOrg      bool
+	}{
+		{
+			name:           "NoProjects",
+			wantDeletedOrg: true,
+		},
+		{
+			name:        "Blocked",
+			policy:      v1alpha1.ChildDeletionPolicyBlock,
+			projects:    projects,
+			wantErr:     true,
+			wantBlocked: true,
+		},
+		{
+			// The provider key may not access the projects of the organization.
+			name:        "BlockedWithOrganizationKey",
+			policy:      v1alpha1.ChildDeletionPolicyBlock,
+			projects:    projects,
+			orgKey:      true,
+			wantErr:     true,
+			wantBlocked: true,
+		},
+		{
+			name:                "Cascade",
+			policy:              v1alpha1.ChildDeletionPolicyCascade,
+			projects:            projects,
+			wantDeletedProjects: []string{"projectID1", "projectID2"},
+			wantDeletedOrg:      true,
+		},
+		{
+			name:                "CascadeWithOrganizationKey",
+			policy:              v1alpha1.ChildDeletionPolicyCascade,
+			projects:            projects,
+			orgKey:              true,
+			wantDeletedProjects: []string{"projectID1", "projectID2"},
+			wantDeletedOrg:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := metav1.Now()
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org", DeletionTimestamp: &now}}
+			meta.SetExternalName(cr, "orgID123")
+			cr.Spec.ForProvider.ChildDeletionPolicy = tt.policy
+			service := &mockService{projects: tt.projects}
+			e := newExternal(service, fake.NewSecretStore())
+			if tt.orgKey {
+				store := fake.NewSecretStore()
+				if _, err := store.PutSecret(context.Background(), testSecretName,
+					awsclient.MongoDBAPICredentials{PublicKey: "public", PrivateKey: "private", OrgID: "orgID123"}, awsclient.PayloadFormat{}, awsclient.SecretOptions{}); err != nil {
+					t.Fatal(err)
+				}
+				e = newOrgKeyExternal(service, store)
+			}
+
+			err := e.Delete(context.Background(), cr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if blocked := cr.GetCondition(v1alpha1.TypeDeletionBlocked).Status == corev1.ConditionTrue; blocked != tt.wantBlocked {
+				t.Errorf("Delete() deletion blocked = %v, want %v", blocked, tt.wantBlocked)
+			}
+			if diff := cmp.Diff(tt.wantDeletedProjects, service.deletedProjects); diff != "" {
+				t.Errorf("Delete() deleted projects -want, +got:\n%s", diff)
+			}
+			if service.deletedOrg != tt.wantDeletedOrg {
+				t.Errorf("Delete() organization deleted = %v, want %v", service.deletedOrg, tt.wantDeletedOrg)
+			}
+			if (cr.Status.AtProvider.DeletedAt != nil) != tt.wantDeletedOrg {
+				t.Errorf("Delete() deletedAt = %v", cr.Status.AtProvider.DeletedAt)
+			}
+		})
+	}
+}
+
+func TestFinalizer(t *testing.T) {
+	const other = "other/finalizer"
+	now := metav1.Now()
+
+	tests := []struct {
+		name               string
+		deleting           bool
+		finalizers         []string
+		deletionPolicy     xpv1.DeletionPolicy
+		managementPolicies xpv1.ManagementPolicies
+		call               func(e *external, cr *v1alpha1.Organization) error
+		wantFinalizers     []string
+	}{
+		{
+			name: "Create",
+			call: func(e *external, cr *v1alpha1.Organization) error {
+				_, err := e.Create(context.Background(), cr)
+				return err
+			},
+			wantFinalizers: []string{FinalizerOrganizationCleanup},
+		},
+		{
+			name: "ObserveImported",
+			call: func(e *external, cr *v1alpha1.Organization) error {
+				meta.SetExternalName(cr, "orgID123")
+				_, err := e.Observe(context.Background(), cr)
+				return err
+			},
+			wantFinalizers: []string{FinalizerOrganizationCleanup},
+		},
+		{
+			// The API server rejects new finalizers on an object that is
+			// being deleted.
+			name:       "DeleteDoesNotAdd",
+			deleting:   true,
+			finalizers: []string{other},
+			call: func(e *external, cr *v1alpha1.Organization) error {
+				meta.SetExternalName(cr, "orgID123")
+				return e.Delete(context.Background(), cr)
+			},
+			wantFinalizers: []string{other},
+		},
+		{
+			name:       "ObserveDeletionRemoves",
+			deleting:   true,
+			finalizers: []string{FinalizerOrganizationCleanup, other},
+			call: func(e *external, cr *v1alpha1.Organization) error {
+				meta.SetExternalName(cr, "orgID123")
+				cr.Status.AtProvider.DeletedAt = &now
+				_, err := e.Observe(context.Background(), cr)
+				return err
+			},
+			wantFinalizers: []string{other},
+		},
+		{
+			name:           "CreateOrphan",
+			deletionPolicy: xpv1.DeletionOrphan,
+			call: func(e *external, cr *v1alpha1.Organization) error {
+				_, err := e.Create(context.Background(), cr)
+				return err
+			},
+		},
+		{
+			name:           "ObserveOrphanRemoves",
+			deletionPolicy: xpv1.DeletionOrphan,
+			finalizers:     []string{FinalizerOrganizationCleanup, other},
+			call: func(e *external, cr *v1alpha1.Organization) error {
+				meta.SetExternalName(cr, "orgID123")
+				_, err := e.Observe(context.Background(), cr)
+				return err
+			},
+			wantFinalizers: []string{other},
+		},
+		{
+			name:               "ObserveOnlyRemoves",
+			managementPolicies: xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+			finalizers:         []string{FinalizerOrganizationCleanup},
+			call: func(e *external, cr *v1alpha1.Organization) error {
+				meta.SetExternalName(cr, "orgID123")
+				_, err := e.Observe(context.Background(), cr)
+				return err
+			},
+		},
+		{
+			// The managed reconciler removes its finalizer without observing
+			// an orphaned organization that is being deleted.
+			name:           "OrphanedDeletionReleases",
+			deleting:       true,
+			deletionPolicy: xpv1.DeletionOrphan,
+			finalizers:     []string{managed.FinalizerName, FinalizerOrganizationCleanup, other},
+			call: func(e *external, cr *v1alpha1.Organization) error {
+				return managedFinalizer{kube: e.kube}.RemoveFinalizer(context.Background(), cr)
+			},
+			wantFinalizers: []string{other},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := v1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			if err := corev1.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org", UID: "uid123", Finalizers: tt.finalizers}}
+			cr.Spec.ForProvider.APIKey = &v1alpha1.OrganizationAPIKey{Roles: []string{"ORG_OWNER"}}
+			if tt.deleting {
+				cr.SetDeletionTimestamp(&now)
+			}
+			if tt.deletionPolicy != "" {
+				cr.SetDeletionPolicy(tt.deletionPolicy)
+			}
+			cr.SetManagementPolicies(tt.managementPolicies)
+			kube := kubefake.NewClientBuilder().WithScheme(scheme).WithObjects(cr).WithStatusSubresource(cr).Build()
+			e := newExternal(&mockService{}, fake.NewSecretStore())
+			e.kube = kube
+			e.finalizer = resource.NewAPIFinalizer(kube, FinalizerOrganizationCleanup)
+			e.escrow.kube = kube
+			e.managementPolicies = tt.managementPolicies != nil
+
+			if err := tt.call(e, cr); err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			got := &v1alpha1.Organization{}
+			if err := kube.Get(context.Background(), types.NamespacedName{Name: cr.Name}, got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.wantFinalizers, got.GetFinalizers()); diff != "" {
+				t.Errorf("finalizers -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserveDeletion(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		verifyErr   error
+		orgKey      bool
+		keyDeleted  bool
+		wantErr     bool
+		wantExists  bool
+		wantDeleted bool
+	}{
+		{
+			name:       "StillDeleting",
+			verifyErr:  &svc.OrganizationExistsError{ID: "orgID123"},
+			wantExists: true,
+		},
+		{
+			name:       "StillDeletingWithOrganizationKey",
+			verifyErr:  &svc.OrganizationExistsError{ID: "orgID123"},
+			orgKey:     true,
+			wantExists: true,
+		},
+		{
+			// Atlas rejects the API key of a deleted organization.
+			name:        "DeletedWithOrganizationKey",
+			orgKey:      true,
+			keyDeleted:  true,
+			wantDeleted: true,
+		},
+		{
+			// The secret must be kept until the deletion is confirmed.
+			name:      "VerifyFailure",
+			verifyErr: errors.New("boom"),
+			wantErr:   true,
+		},
+		{
+			name:        "Deleted",
+			wantDeleted: true,
+		},
+		{
+			name:        "ForceDeleted",
+			policy:      v1alpha1.SecretDeletionPolicyForceDelete,
+			wantDeleted: true,
+		},
+		{
+			name:   "Retained",
+			policy: v1alpha1.SecretDeletionPolicyRetain,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := fake.NewSecretStore()
+			if _, err := store.PutSecret(context.Background(), testSecretName,
+				awsclient.MongoDBAPICredentials{PublicKey: "public", PrivateKey: "private"}, awsclient.PayloadFormat{}, awsclient.SecretOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			now := metav1.Now()
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org", DeletionTimestamp: &now}}
+			meta.SetExternalName(cr, "orgID123")
+			cr.Status.AtProvider.DeletedAt = &now
+			cr.Spec.ForProvider.AWSSecretsConfig.SecretDeletionPolicy = tt.policy
+
+			service := &mockService{verifyErr: tt.verifyErr, unauthorized: tt.keyDeleted}
+			e := newExternal(service, store)
+			if tt.orgKey {
+				e = newOrgKeyExternal(service, store)
+			}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Observe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			_, err = store.GetSecret(context.Background(), testSecretName)
+			if deleted := err != nil; deleted != tt.wantDeleted {
+				t.Errorf("secret deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestSecretName(t *testing.T) {
+	tests := []struct {
+		name       string
+		naming     *apisv1alpha1.SecretNaming
+		secretName *string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name: "Default",
+			want: "product/mongodb/test-org",
+		},
+		{
+			name:       "SecretName",
+			secretName: pointer.String("other-org"),
+			want:       "product/mongodb/other-org",
+		},
+		{
+			name:   "Prefix",
+			naming: &apisv1alpha1.SecretNaming{Prefix: pointer.String("mongodb/")},
+			want:   "mongodb/test-org",
+		},
+		{
+			name: "Template",
+			naming: &apisv1alpha1.SecretNaming{
+				Prefix:   pointer.String("ignored/"),
+				Template: pointer.String("{{.Env}}/mongodb/{{.Name}}"),
+				Env:      "dev",
+			},
+			want: "dev/mongodb/test-org",
+		},
+		{
+			name:    "UnknownField",
+			naming:  &apisv1alpha1.SecretNaming{Template: pointer.String("{{.Stage}}/{{.Name}}")},
+			wantErr: true,
+		},
+		{
+			name:    "EmptyName",
+			naming:  &apisv1alpha1.SecretNaming{Template: pointer.String("{{.Env}}")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc := &apisv1alpha1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       apisv1alpha1.ProviderConfigSpec{SecretNaming: tt.naming},
+			}
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org"}}
+			cr.Spec.ForProvider.AWSSecretsConfig.SecretName = tt.secretName
+
+			got, err := secretName(pc, cr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("secretName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("secretName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObserveSettings(t *testing.T) {
+	atlas := svc.OrganizationSettings{
+		APIAccessListRequired:   pointer.Bool(false),
+		MultiFactorAuthRequired: pointer.Bool(true),
+		SecurityContact:         pointer.String("security@example.com"),
+	}
+
+	tests := []struct {
+		name      string
+		settings  *v1alpha1.OrganizationSettings
+		wantDrift []string
+		wantPatch svc.OrganizationSettings
+	}{
+		{
+			name: "NotManaged",
+		},
+		{
+			name:     "InSync",
+			settings: &v1alpha1.OrganizationSettings{MultiFactorAuthRequired: pointer.Bool(true)},
+		},
+		{
+			name: "Drifted",
+			settings: &v1alpha1.OrganizationSettings{
+				APIAccessListRequired:   pointer.Bool(true),
+				MultiFactorAuthRequired: pointer.Bool(true),
+				RestrictEmployeeAccess:  pointer.Bool(true),
+			},
+			wantDrift: []string{"apiAccessListRequired", "restrictEmployeeAccess"},
+			wantPatch: svc.OrganizationSettings{APIAccessListRequired: pointer.Bool(true), RestrictEmployeeAccess: pointer.Bool(true)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org"}}
+			cr.Spec.ForProvider.Settings = tt.settings
+
+			patch, drift, err := newExternal(&mockService{settings: atlas}, fake.NewSecretStore()).observeSettings(context.Background(), cr, "orgID123")
+			if err != nil {
+				t.Fatalf("observeSettings() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantDrift, drift); diff != "" {
+				t.Errorf("observeSettings() drift -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPatch, patch); diff != "" {
+				t.Errorf("observeSettings() patch -want, +got:\n%s", diff)
+			}
+			if (cr.Status.AtProvider.Settings != nil) != (tt.settings != nil) {
+				t.Errorf("observeSettings() observed settings = %v", cr.Status.AtProvider.Settings)
+			}
+		})
+	}
+}
+
+func TestReplicas(t *testing.T) {
+	tests := []struct {
+		name         string
+		current      map[string]*string
+		want         []v1alpha1.SecretReplicaRegion
+		wantUpToDate bool
+		wantReplicas map[string]*string
+	}{
+		{
+			name:         "InSync",
+			current:      map[string]*string{"eu-west-1": nil},
+			want:         []v1alpha1.SecretReplicaRegion{{Region: "eu-west-1"}},
+			wantUpToDate: true,
+			wantReplicas: map[string]*string{"eu-west-1": nil},
+		},
+		{
+			name:         "Add",
+			current:      map[string]*string{"eu-west-1": nil},
+			want:         []v1alpha1.SecretReplicaRegion{{Region: "eu-west-1"}, {Region: "us-east-1", KMSKeyID: pointer.String("key123")}},
+			wantReplicas: map[string]*string{"eu-west-1": nil, "us-east-1": pointer.String("key123")},
+		},
+		{
+			name:         "Remove",
+			current:      map[string]*string{"eu-west-1": nil, "us-east-1": nil},
+			want:         []v1alpha1.SecretReplicaRegion{{Region: "eu-west-1"}},
+			wantReplicas: map[string]*string{"eu-west-1": nil},
+		},
+		{
+			name:         "Replace",
+			current:      map[string]*string{"eu-west-1": nil},
+			want:         []v1alpha1.SecretReplicaRegion{{Region: "us-east-1"}},
+			wantReplicas: map[string]*string{"us-east-1": nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org", UID: "uid123"}}
+			meta.SetExternalName(cr, "orgID123")
+			cr.Status.AtProvider.SecretName = testSecretName
+			cr.Spec.ForProvider.AWSSecretsConfig.ReplicaRegions = tt.want
+			store := fake.NewSecretStore()
+			if _, err := store.PutSecret(context.Background(), testSecretName,
+				awsclient.MongoDBAPICredentials{PublicKey: "public", PrivateKey: "private", OrgID: "orgID123"}, awsclient.PayloadFormat{}, awsclient.SecretOptions{Tags: resourceTags(cr)}); err != nil {
+				t.Fatal(err)
+			}
+			store.Secrets[testSecretName].Replicas = tt.current
+			e := newExternal(&mockService{}, store)
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantReplicas, store.Secrets[testSecretName].Replicas); diff != "" {
+				t.Errorf("Update() replicas -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourcePolicy(t *testing.T) {
+	const policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"secretsmanager:DeleteSecret","Resource":"*"}]}`
+	const reordered = `{"Statement":[{"Action":"secretsmanager:DeleteSecret","Effect":"Deny","Principal":"*","Resource":"*"}],"Version":"2012-10-17"}`
+
+	tests := []struct {
+		name         string
+		current      *string
+		want         *string
+		wantUpToDate bool
+		wantCalls    []string
+		wantPolicy   *string
+	}{
+		{
+			name:         "NoPolicy",
+			wantUpToDate: true,
+		},
+		{
+			name:         "InSync",
+			current:      pointer.String(reordered),
+			want:         pointer.String(policy),
+			wantUpToDate: true,
+			wantPolicy:   pointer.String(reordered),
+		},
+		{
+			name:       "Put",
+			want:       pointer.String(policy),
+			wantCalls:  []string{"PutResourcePolicy"},
+			wantPolicy: pointer.String(policy),
+		},
+		{
+			name:      "Delete",
+			current:   pointer.String(policy),
+			wantCalls: []string{"DeleteResourcePolicy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org", UID: "uid123"}}
+			meta.SetExternalName(cr, "orgID123")
+			cr.Status.AtProvider.SecretName = testSecretName
+			cr.Spec.ForProvider.AWSSecretsConfig.ResourcePolicy = tt.want
+			store := fake.NewSecretStore()
+			if _, err := store.PutSecret(context.Background(), testSecretName,
+				awsclient.MongoDBAPICredentials{PublicKey: "public", PrivateKey: "private", OrgID: "orgID123"}, awsclient.PayloadFormat{}, awsclient.SecretOptions{Tags: resourceTags(cr)}); err != nil {
+				t.Fatal(err)
+			}
+			store.Secrets[testSecretName].ResourcePolicy = tt.current
+			e := newExternal(&mockService{}, store)
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+			store.Calls = nil
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			var calls []string
+			for _, c := range store.Calls {
+				if strings.HasSuffix(c, "ResourcePolicy") && c != "GetResourcePolicy" {
+					calls = append(calls, c)
+				}
+			}
+			if diff := cmp.Diff(tt.wantCalls, calls); diff != "" {
+				t.Errorf("Update() resource policy calls -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPolicy, store.Secrets[testSecretName].ResourcePolicy); diff != "" {
+				t.Errorf("Update() resource policy -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMoveSecret(t *testing.T) {
+	const otherSecretName = "mongodb/test-org"
+
+	tests := []struct {
+		name  string
+		moves [][2]string
+	}{
+		{
+			name:  "Move",
+			moves: [][2]string{{testSecretName, otherSecretName}},
+		},
+		{
+			// The old name can be used again right away.
+			name:  "MoveBack",
+			moves: [][2]string{{testSecretName, otherSecretName}, {otherSecretName, testSecretName}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := fake.NewSecretStore()
+			if _, err := store.PutSecret(context.Background(), testSecretName,
+				awsclient.MongoDBAPICredentials{PublicKey: "public", PrivateKey: "private"}, awsclient.PayloadFormat{}, awsclient.SecretOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org"}}
+			meta.SetExternalName(cr, "orgID123")
+			e := newExternal(&mockService{}, store)
+
+			for _, m := range tt.moves {
+				if err := e.moveSecret(context.Background(), cr, m[0], m[1]); err != nil {
+					t.Fatalf("moveSecret(%s, %s) error = %v", m[0], m[1], err)
+				}
+			}
+			to := tt.moves[len(tt.moves)-1][1]
+			if diff := cmp.Diff(to, cr.Status.AtProvider.SecretName); diff != "" {
+				t.Errorf("moveSecret() secretName -want, +got:\n%s", diff)
+			}
+			var names []string
+			for name := range store.Secrets {
+				names = append(names, name)
+			}
+			if diff := cmp.Diff([]string{to}, names); diff != "" {
+				t.Errorf("moveSecret() secrets -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffCIDRBlocks(t *testing.T) {
+	tests := []struct {
+		name       string
+		want       []string
+		got        []string
+		wantAdd    []string
+		wantRemove []string
+	}{
+		{
+			name: "InSync",
+			want: []string{"203.0.113.10", "198.51.100.0/24"},
+			got:  []string{"198.51.100.0/24", "203.0.113.10/32"},
+		},
+		{
+			name:       "Drifted",
+			want:       []string{"203.0.113.10", "198.51.100.0/24"},
+			got:        []string{"203.0.113.10/32", "192.0.2.1/32"},
+			wantAdd:    []string{"198.51.100.0/24"},
+			wantRemove: []string{"192.0.2.1/32"},
+		},
+		{
+			name:    "Empty",
+			want:    []string{"2001:db8::1"},
+			wantAdd: []string{"2001:db8::1/128"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := diffCIDRBlocks(tt.want, tt.got)
+			if diff := cmp.Diff(tt.wantAdd, add); diff != "" {
+				t.Errorf("diffCIDRBlocks() add -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRemove, remove); diff != "" {
+				t.Errorf("diffCIDRBlocks() remove -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
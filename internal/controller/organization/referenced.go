@@ -0,0 +1,114 @@
+package organization
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	apisv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// referencedServiceTTL is how long the Atlas client of a referenced
+// organization is reused before its API key is read again.
+const referencedServiceTTL = 10 * time.Minute
+
+// referencedServices caches the Atlas clients of referenced organizations.
+var referencedServices = &serviceCache{entries: map[string]cachedService{}}
+
+type cachedService struct {
+	service svc.Service
+	expires time.Time
+}
+
+// serviceCache caches Atlas clients by the API key they authenticate with.
+type serviceCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedService
+}
+
+func (c *serviceCache) get(key string, now time.Time) (svc.Service, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok || now.After(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.service, true
+}
+
+func (c *serviceCache) put(key string, s svc.Service, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cachedService{service: s, expires: now.Add(referencedServiceTTL)}
+}
+
+// referencedKey identifies the API key of an organization. It changes when
+// the key is rotated or its secret is moved.
+func referencedKey(cr *v1alpha1.Organization) string {
+	return string(cr.GetUID()) + "/" + cr.Status.AtProvider.SecretName + "/" + cr.Status.AtProvider.APIKeyID
+}
+
+// ConnectReferenced returns the ID of a referenced organization and an Atlas
+// client authenticated with the API key of the organization. Resources that
+// belong to an organization connect with it. Only the stored API key of the
+// organization is read, and the client is reused until the key changes.
+func ConnectReferenced(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error) {
+	c := &connector{kube: kube, newServiceFn: svc.NewService, newAWSClientFn: awsclient.NewSecretStore}
+	return c.connectReferenced(ctx, ref, referencedServices, time.Now())
+}
+
+func (c *connector) connectReferenced(ctx context.Context, ref xpv1.Reference, cache *serviceCache, now time.Time) (string, svc.Service, error) {
+	cr := &v1alpha1.Organization{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, cr); err != nil {
+		return "", nil, errors.Wrap(err, errGetOrganization)
+	}
+	orgID := meta.GetExternalName(cr)
+	if orgID == "" {
+		return "", nil, errors.Errorf(errFmtOrganizationNotReady, ref.Name)
+	}
+	key := referencedKey(cr)
+	if s, ok := cache.get(key, now); ok {
+		return orgID, s, nil
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return "", nil, errors.Wrap(err, errGetPC)
+	}
+	var awsClient awsclient.SecretStore
+	if !usesVault(cr) {
+		aws := pc.Spec.Credentials.AWS
+		if aws == nil || aws.SecretsManager == nil {
+			return "", nil, errors.New(errInvalidPCConfig)
+		}
+		var err error
+		if awsClient, err = c.newAWSClientFn(ctx, aws.SecretsManager.Region); err != nil {
+			return "", nil, errors.Wrap(err, errAWSClient)
+		}
+	}
+	credSink, name, err := c.credentialSink(ctx, pc, cr, awsClient)
+	if err != nil {
+		return "", nil, err
+	}
+	if n := cr.Status.AtProvider.SecretName; n != "" {
+		name = n
+	}
+	creds, err := credSink.Get(ctx, name)
+	if err != nil {
+		return "", nil, errors.Wrap(err, errGetOrganizationKey)
+	}
+	s := c.newServiceFn(svc.Credentials{PublicKey: creds.PublicKey, PrivateKey: creds.PrivateKey})
+	cache.put(key, s, now)
+	return orgID, s, nil
+}
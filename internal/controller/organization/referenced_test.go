@@ -0,0 +1,111 @@
+package organization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	apisv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws/fake"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+func TestConnectReferenced(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		apiKeyID  string
+		at        time.Time
+		wantReads int
+	}{
+		{
+			name:      "Cached",
+			apiKeyID:  "key-1",
+			at:        now.Add(time.Minute),
+			wantReads: 1,
+		},
+		{
+			name:      "KeyRotated",
+			apiKeyID:  "key-2",
+			at:        now.Add(time.Minute),
+			wantReads: 2,
+		},
+		{
+			name:      "Expired",
+			apiKeyID:  "key-1",
+			at:        now.Add(referencedServiceTTL + time.Minute),
+			wantReads: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := v1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			if err := apisv1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			cr := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "test-org", UID: "uid"}}
+			meta.SetExternalName(cr, "orgID123")
+			cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+			cr.Status.AtProvider.SecretName = testSecretName
+			cr.Status.AtProvider.APIKeyID = "key-1"
+			pc := &apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+			pc.Spec.Credentials.Source = CredentialsSourceAWS
+			pc.Spec.Credentials.AWS = &apisv1alpha1.AWSCredentialsSource{SecretsManager: &apisv1alpha1.AWSSecretsManagerReference{Region: "eu-central-1"}}
+			kube := kubefake.NewClientBuilder().WithScheme(scheme).WithObjects(cr, pc).WithStatusSubresource(cr).Build()
+
+			// Only the API key of the organization is in the store, not the
+			// credentials of the ProviderConfig.
+			store := fake.NewSecretStore()
+			if _, err := store.PutSecret(context.Background(), testSecretName, awsclient.MongoDBAPICredentials{OrgID: "orgID123", PublicKey: "public", PrivateKey: "private"}, awsclient.PayloadFormat{}, awsclient.SecretOptions{}); err != nil {
+				t.Fatal(err)
+			}
+			var got []svc.Credentials
+			c := &connector{
+				kube:           kube,
+				newAWSClientFn: func(_ context.Context, _ string) (awsclient.SecretStore, error) { return store, nil },
+				newServiceFn: func(creds svc.Credentials) svc.Service {
+					got = append(got, creds)
+					return &mockService{}
+				},
+			}
+			cache := &serviceCache{entries: map[string]cachedService{}}
+			ref := xpv1.Reference{Name: "test-org"}
+
+			if _, _, err := c.connectReferenced(context.Background(), ref, cache, now); err != nil {
+				t.Fatalf("connectReferenced() error = %v", err)
+			}
+			cr.Status.AtProvider.APIKeyID = tt.apiKeyID
+			if err := kube.Status().Update(context.Background(), cr); err != nil {
+				t.Fatal(err)
+			}
+			orgID, _, err := c.connectReferenced(context.Background(), ref, cache, tt.at)
+			if err != nil {
+				t.Fatalf("connectReferenced() error = %v", err)
+			}
+			if orgID != "orgID123" {
+				t.Errorf("connectReferenced() orgID = %q, want %q", orgID, "orgID123")
+			}
+			if len(got) != tt.wantReads {
+				t.Errorf("connectReferenced() read the API key %d times, want %d", len(got), tt.wantReads)
+			}
+			if diff := cmp.Diff(svc.Credentials{PublicKey: "public", PrivateKey: "private"}, got[0]); diff != "" {
+				t.Errorf("connectReferenced() credentials -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
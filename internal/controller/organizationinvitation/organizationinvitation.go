@@ -0,0 +1,214 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/shared"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/team"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotInvitation    = "managed resource is not an OrganizationInvitation custom resource"
+	errGetInvitation    = "cannot get invitation"
+	errCreateInvitation = "cannot create invitation"
+	errUpdateInvitation = "cannot update invitation"
+	errDeleteInvitation = "cannot delete invitation"
+	errFindUser         = "cannot find organization user"
+)
+
+// Setup adds a controller that reconciles OrganizationInvitation managed
+// resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.OrganizationInvitationGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OrganizationInvitationGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.OrganizationInvitation{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization the user is
+// invited to.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationInvitation)
+	if !ok {
+		return nil, errors.New(errNotInvitation)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	orgID, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: client, orgID: orgID, logger: c.logger}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client svc.Service
+	orgID  string
+	logger logging.Logger
+}
+
+// Observe reports a pending invitation as existing. Atlas removes accepted
+// and expired invitations; an accepted invitation is recognized by the user
+// being a member of the organization, an expired one is sent again.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationInvitation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInvitation)
+	}
+	cr.Status.AtProvider.OrgID = c.orgID
+
+	var inv *svc.Invitation
+	if id := meta.GetExternalName(cr); id != "" {
+		var err error
+		inv, err = c.client.GetInvitation(ctx, c.orgID, id)
+		if err != nil && !svc.IsNotFoundError(err) {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetInvitation)
+		}
+	}
+	if inv == nil {
+		_, err := c.client.FindOrganizationUser(ctx, c.orgID, cr.Spec.ForProvider.Username)
+		if svc.IsNotFoundError(err) {
+			cr.Status.AtProvider.Accepted = false
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFindUser)
+		}
+		cr.Status.AtProvider.Accepted = true
+		cr.Status.AtProvider.ExpiresAt = nil
+		cr.SetConditions(xpv1.Available())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	teamIDs, err := c.teamIDs(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider.ID = inv.ID
+	cr.Status.AtProvider.InviterUsername = inv.InviterUsername
+	cr.Status.AtProvider.Accepted = false
+	if inv.ExpiresAt != nil {
+		t := metav1.NewTime(*inv.ExpiresAt)
+		cr.Status.AtProvider.ExpiresAt = &t
+	}
+	cr.SetConditions(xpv1.Available())
+
+	upToDate := shared.SameSet(inv.Roles, cr.Spec.ForProvider.Roles) && shared.SameSet(inv.TeamIDs, teamIDs)
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationInvitation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInvitation)
+	}
+	teamIDs, err := c.teamIDs(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	inv, err := c.client.CreateInvitation(ctx, c.orgID, svc.Invitation{
+		Username: cr.Spec.ForProvider.Username,
+		Roles:    cr.Spec.ForProvider.Roles,
+		TeamIDs:  teamIDs,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInvitation)
+	}
+	meta.SetExternalName(cr, inv.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationInvitation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInvitation)
+	}
+	teamIDs, err := c.teamIDs(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := c.client.UpdateInvitation(ctx, c.orgID, meta.GetExternalName(cr), cr.Spec.ForProvider.Roles, teamIDs); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInvitation)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete revokes a pending invitation. Users that accepted the invitation
+// stay members of the organization.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OrganizationInvitation)
+	if !ok {
+		return errors.New(errNotInvitation)
+	}
+	id := meta.GetExternalName(cr)
+	if cr.Status.AtProvider.Accepted || id == "" {
+		return nil
+	}
+	err := c.client.DeleteInvitation(ctx, c.orgID, id)
+	if err != nil && !svc.IsNotFoundError(err) {
+		return errors.Wrap(err, errDeleteInvitation)
+	}
+	return nil
+}
+
+// teamIDs resolves the referenced Teams to their Atlas IDs.
+func (c *external) teamIDs(ctx context.Context, cr *v1alpha1.OrganizationInvitation) ([]string, error) {
+	ids := make([]string, 0, len(cr.Spec.ForProvider.TeamRefs))
+	for _, ref := range cr.Spec.ForProvider.TeamRefs {
+		id, err := team.ReferencedID(ctx, c.kube, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,154 @@
+package organizationinvitation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	kubefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// mockService serves a single invitation and member of an organization.
+type mockService struct {
+	svc.Service
+	invitation *svc.Invitation
+	user       *svc.User
+	roles      []string
+	teamIDs    []string
+}
+
+func (m *mockService) GetInvitation(_ context.Context, _, _ string) (*svc.Invitation, error) {
+	if m.invitation == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.invitation, nil
+}
+
+func (m *mockService) FindOrganizationUser(_ context.Context, _, _ string) (*svc.User, error) {
+	if m.user == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.user, nil
+}
+
+func (m *mockService) UpdateInvitation(_ context.Context, _, _ string, roles, teamIDs []string) error {
+	m.roles, m.teamIDs = roles, teamIDs
+	return nil
+}
+
+// newKube returns a fake API server with a Team named after its ID.
+func newKube(t *testing.T) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	team := &v1alpha1.Team{ObjectMeta: metav1.ObjectMeta{Name: "team-id"}}
+	meta.SetExternalName(team, "team-id")
+	return kubefake.NewClientBuilder().WithScheme(scheme).WithObjects(team).Build()
+}
+
+func newInvitation(id string) *v1alpha1.OrganizationInvitation {
+	cr := &v1alpha1.OrganizationInvitation{ObjectMeta: metav1.ObjectMeta{Name: "jane"}}
+	meta.SetExternalName(cr, id)
+	cr.Spec.ForProvider.Username = "jane.doe@example.com"
+	cr.Spec.ForProvider.Roles = []string{"ORG_MEMBER"}
+	cr.Spec.ForProvider.TeamRefs = []xpv1.Reference{{Name: "team-id"}}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		invitation   *svc.Invitation
+		user         *svc.User
+		wantExists   bool
+		wantUpToDate bool
+		wantAccepted bool
+	}{
+		{
+			name: "NotInvited",
+		},
+		{
+			name:         "Pending",
+			id:           "invitation-id",
+			invitation:   &svc.Invitation{ID: "invitation-id", Roles: []string{"ORG_MEMBER"}, TeamIDs: []string{"team-id"}},
+			wantExists:   true,
+			wantUpToDate: true,
+		},
+		{
+			name:       "RolesChangedOutsideCrossplane",
+			id:         "invitation-id",
+			invitation: &svc.Invitation{ID: "invitation-id", Roles: []string{"ORG_OWNER"}, TeamIDs: []string{"team-id"}},
+			wantExists: true,
+		},
+		{
+			name:         "Accepted",
+			id:           "invitation-id",
+			user:         &svc.User{ID: "user-id", Username: "jane.doe@example.com"},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantAccepted: true,
+		},
+		{
+			// A member added outside Crossplane is not invited again.
+			name:         "MemberAddedOutsideCrossplane",
+			user:         &svc.User{ID: "user-id", Username: "jane.doe@example.com"},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantAccepted: true,
+		},
+		{
+			name: "Expired",
+			id:   "invitation-id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := newInvitation(tt.id)
+			m := &mockService{invitation: tt.invitation, user: tt.user}
+			e := &external{kube: newKube(t), client: m, orgID: "org", logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+			if cr.Status.AtProvider.Accepted != tt.wantAccepted {
+				t.Errorf("Observe() Accepted = %v, want %v", cr.Status.AtProvider.Accepted, tt.wantAccepted)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cr := newInvitation("invitation-id")
+	m := &mockService{}
+
+	if _, err := (&external{kube: newKube(t), client: m, orgID: "org", logger: logging.NewNopLogger()}).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"ORG_MEMBER"}, m.roles); diff != "" {
+		t.Errorf("Update() roles -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"team-id"}, m.teamIDs); diff != "" {
+		t.Errorf("Update() teamIDs -want, +got:\n%s", diff)
+	}
+}
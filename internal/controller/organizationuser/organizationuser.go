@@ -0,0 +1,199 @@
+package organizationuser
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/shared"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+// roleMember is the role a member of an organization keeps once all other
+// roles were revoked.
+const roleMember = "ORG_MEMBER"
+
+const (
+	errNotUser     = "managed resource is not an OrganizationUser custom resource"
+	errFindUser    = "cannot find organization user"
+	errUpdateRoles = "cannot update roles of organization user"
+	errRemoveUser  = "cannot remove user from organization"
+	errRevokeRoles = "cannot revoke roles of organization user"
+
+	errFmtNotMember = "user %s is not a member of the organization, invite them with an OrganizationInvitation"
+)
+
+// Setup adds a controller that reconciles OrganizationUser managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.OrganizationUserGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OrganizationUserGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.OrganizationUser{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization of the user.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationUser)
+	if !ok {
+		return nil, errors.New(errNotUser)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	orgID, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: client, orgID: orgID, logger: c.logger}, nil
+}
+
+type external struct {
+	client svc.Service
+	orgID  string
+	logger logging.Logger
+}
+
+// Observe compares the roles of the user with the spec. Unless the user is
+// removed on deletion, a deleted OrganizationUser stops existing once its
+// roles were revoked.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUser)
+	}
+	user, err := c.client.FindOrganizationUser(ctx, c.orgID, cr.Spec.ForProvider.Username)
+	if svc.IsNotFoundError(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFindUser)
+	}
+
+	roles := user.OrganizationRoles(c.orgID)
+	sort.Strings(roles)
+	cr.Status.AtProvider.ID = user.ID
+	cr.Status.AtProvider.OrgID = c.orgID
+	cr.Status.AtProvider.Roles = roles
+	cr.Status.AtProvider.TeamIDs = user.TeamIDs
+	cr.SetConditions(xpv1.Available())
+
+	if meta.WasDeleted(cr) && !cr.Spec.ForProvider.RemoveOnDelete {
+		revoked := shared.SameSet(roles, revokedRoles(roles, cr.Spec.ForProvider.Roles))
+		return managed.ExternalObservation{ResourceExists: !revoked}, nil
+	}
+
+	upToDate := shared.SameSet(roles, cr.Spec.ForProvider.Roles)
+	if !upToDate {
+		c.logger.Debug("Organization roles changed outside of Crossplane", "username", user.Username, "roles", roles)
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+// Create fails because users join an organization by accepting an
+// invitation.
+func (c *external) Create(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUser)
+	}
+	return managed.ExternalCreation{}, errors.Errorf(errFmtNotMember, cr.Spec.ForProvider.Username)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationUser)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUser)
+	}
+	if err := c.client.UpdateOrganizationUserRoles(ctx, c.orgID, cr.Status.AtProvider.ID, cr.Spec.ForProvider.Roles); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRoles)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete revokes the roles of the spec from the user. The user is only
+// removed from the organization if removeOnDelete is true, because the user
+// may have been a member before the OrganizationUser was created.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OrganizationUser)
+	if !ok {
+		return errors.New(errNotUser)
+	}
+	if cr.Status.AtProvider.ID == "" {
+		return nil
+	}
+	if cr.Spec.ForProvider.RemoveOnDelete {
+		err := c.client.RemoveOrganizationUser(ctx, c.orgID, cr.Status.AtProvider.ID)
+		if err != nil && !svc.IsNotFoundError(err) {
+			return errors.Wrap(err, errRemoveUser)
+		}
+		return nil
+	}
+	roles := revokedRoles(cr.Status.AtProvider.Roles, cr.Spec.ForProvider.Roles)
+	err := c.client.UpdateOrganizationUserRoles(ctx, c.orgID, cr.Status.AtProvider.ID, roles)
+	if err != nil && !svc.IsNotFoundError(err) {
+		return errors.Wrap(err, errRevokeRoles)
+	}
+	return nil
+}
+
+// revokedRoles returns the roles of a user once the supplied roles were
+// revoked. A member of an organization needs at least one role.
+func revokedRoles(roles, revoke []string) []string {
+	revoked := map[string]bool{}
+	for _, r := range revoke {
+		revoked[r] = true
+	}
+	var kept []string
+	for _, r := range roles {
+		if !revoked[r] {
+			kept = append(kept, r)
+		}
+	}
+	if len(kept) == 0 {
+		return []string{roleMember}
+	}
+	return kept
+}
@@ -0,0 +1,192 @@
+package organizationuser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+const orgID = "org"
+
+// mockService serves a single member of an organization.
+type mockService struct {
+	svc.Service
+	user    *svc.User
+	roles   []string
+	removed bool
+}
+
+func (m *mockService) FindOrganizationUser(_ context.Context, _, _ string) (*svc.User, error) {
+	if m.user == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.user, nil
+}
+
+func (m *mockService) UpdateOrganizationUserRoles(_ context.Context, _, _ string, roles []string) error {
+	m.roles = roles
+	return nil
+}
+
+func (m *mockService) RemoveOrganizationUser(_ context.Context, _, _ string) error {
+	m.removed = true
+	return nil
+}
+
+// member returns a member of the organization with the supplied roles.
+func member(roles ...string) *svc.User {
+	u := &svc.User{ID: "user-id", Username: "jane.doe@example.com"}
+	for _, r := range roles {
+		u.Roles = append(u.Roles, svc.UserRole{OrgID: orgID, RoleName: r})
+	}
+	return u
+}
+
+func TestObserve(t *testing.T) {
+	deleted := metav1.Now()
+
+	tests := []struct {
+		name           string
+		roles          []string
+		removeOnDelete bool
+		deletion       *metav1.Time
+		user           *svc.User
+		wantExists     bool
+		wantUpToDate   bool
+	}{
+		{
+			name: "NotAMember",
+		},
+		{
+			name:         "InSync",
+			roles:        []string{"ORG_MEMBER", "ORG_BILLING_ADMIN"},
+			user:         member("ORG_BILLING_ADMIN", "ORG_MEMBER"),
+			wantExists:   true,
+			wantUpToDate: true,
+		},
+		{
+			name:         "RoleAddedOutsideCrossplane",
+			roles:        []string{"ORG_MEMBER"},
+			user:         member("ORG_MEMBER", "ORG_OWNER"),
+			wantExists:   true,
+			wantUpToDate: false,
+		},
+		{
+			name:       "DeletingRolesNotRevoked",
+			roles:      []string{"ORG_BILLING_ADMIN"},
+			deletion:   &deleted,
+			user:       member("ORG_BILLING_ADMIN", "ORG_GROUP_CREATOR"),
+			wantExists: true,
+		},
+		{
+			// The user stays a member of the organization.
+			name:     "DeletingRolesRevoked",
+			roles:    []string{"ORG_BILLING_ADMIN"},
+			deletion: &deleted,
+			user:     member("ORG_GROUP_CREATOR"),
+		},
+		{
+			name:           "DeletingRemoveOnDelete",
+			roles:          []string{"ORG_BILLING_ADMIN"},
+			removeOnDelete: true,
+			deletion:       &deleted,
+			user:           member("ORG_GROUP_CREATOR"),
+			wantExists:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.OrganizationUser{ObjectMeta: metav1.ObjectMeta{Name: "jane", DeletionTimestamp: tt.deletion}}
+			cr.Spec.ForProvider.Username = "jane.doe@example.com"
+			cr.Spec.ForProvider.Roles = tt.roles
+			cr.Spec.ForProvider.RemoveOnDelete = tt.removeOnDelete
+			e := &external{client: &mockService{user: tt.user}, orgID: orgID, logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cr := &v1alpha1.OrganizationUser{ObjectMeta: metav1.ObjectMeta{Name: "jane"}}
+	cr.Spec.ForProvider.Roles = []string{"ORG_MEMBER"}
+	cr.Status.AtProvider.ID = "user-id"
+	m := &mockService{}
+
+	if _, err := (&external{client: m, orgID: orgID, logger: logging.NewNopLogger()}).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"ORG_MEMBER"}, m.roles); diff != "" {
+		t.Errorf("Update() roles -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name           string
+		roles          []string
+		current        []string
+		removeOnDelete bool
+		wantRoles      []string
+		wantRemoved    bool
+	}{
+		{
+			// The user was a member before the OrganizationUser was created.
+			name:      "RevokeManagedRoles",
+			roles:     []string{"ORG_BILLING_ADMIN"},
+			current:   []string{"ORG_BILLING_ADMIN", "ORG_GROUP_CREATOR"},
+			wantRoles: []string{"ORG_GROUP_CREATOR"},
+		},
+		{
+			name:      "KeepMembership",
+			roles:     []string{"ORG_OWNER"},
+			current:   []string{"ORG_OWNER"},
+			wantRoles: []string{roleMember},
+		},
+		{
+			name:           "RemoveOnDelete",
+			roles:          []string{"ORG_OWNER"},
+			current:        []string{"ORG_OWNER"},
+			removeOnDelete: true,
+			wantRemoved:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.OrganizationUser{ObjectMeta: metav1.ObjectMeta{Name: "jane"}}
+			cr.Spec.ForProvider.Roles = tt.roles
+			cr.Spec.ForProvider.RemoveOnDelete = tt.removeOnDelete
+			cr.Status.AtProvider.ID = "user-id"
+			cr.Status.AtProvider.Roles = tt.current
+			m := &mockService{}
+
+			if err := (&external{client: m, orgID: orgID, logger: logging.NewNopLogger()}).Delete(context.Background(), cr); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantRoles, m.roles); diff != "" {
+				t.Errorf("Delete() roles -want, +got:\n%s", diff)
+			}
+			if m.removed != tt.wantRemoved {
+				t.Errorf("Delete() removed = %v, want %v", m.removed, tt.wantRemoved)
+			}
+		})
+	}
+}
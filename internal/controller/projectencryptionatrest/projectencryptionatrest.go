@@ -0,0 +1,263 @@
+package projectencryptionatrest
+
+import (
+	"context"
+	"strings"
+
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/cloudprovideraccessrole"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotEncryptionAtRest = "managed resource is not a ProjectEncryptionAtRest custom resource"
+	errGetEncryption       = "cannot get encryption at rest of project"
+	errUpdateEncryption    = "cannot enable encryption at rest of project"
+	errDisableEncryption   = "cannot disable encryption at rest of project"
+	errGetRole             = "cannot get cloud provider access role"
+	errNewKeyStore         = "cannot create AWS KMS client"
+	errCheckKeyPolicy      = "KMS key cannot be used by the cloud provider access role"
+	errKeyInvalid          = "Atlas reports that it cannot use the KMS key"
+	errNoRole              = "roleRef or roleID is required"
+
+	errFmtRoleNotAuthorized = "cloud provider access role %s is not authorized"
+	errFmtParseKeyARN       = "cannot parse KMS key ARN %s"
+)
+
+// keyActions are the actions Atlas performs with the key.
+var keyActions = []string{"kms:Encrypt", "kms:Decrypt", "kms:DescribeKey"}
+
+// Setup adds a controller that reconciles ProjectEncryptionAtRest managed
+// resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectEncryptionAtRestGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:          mgr.GetClient(),
+			logger:        o.Logger,
+			connectFn:     organization.ConnectReferenced,
+			newKeyStoreFn: awsclient.NewKeyStore,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectEncryptionAtRestGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ProjectEncryptionAtRest{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube          client.Client
+	logger        logging.Logger
+	connectFn     func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+	newKeyStoreFn func(ctx context.Context, region string) (awsclient.KeyStore, error)
+}
+
+// Connect connects to Atlas with the API key of the organization that owns
+// the project.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectEncryptionAtRest)
+	if !ok {
+		return nil, errors.New(errNotEncryptionAtRest)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	_, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: client, newKeyStoreFn: c.newKeyStoreFn, logger: c.logger}, nil
+}
+
+type external struct {
+	kube          client.Client
+	client        svc.Service
+	newKeyStoreFn func(ctx context.Context, region string) (awsclient.KeyStore, error)
+	logger        logging.Logger
+}
+
+// Observe reports whether encryption at rest is enabled with the key of the
+// spec. The external name is the project ID once it was enabled.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectEncryptionAtRest)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEncryptionAtRest)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	config, err := c.client.GetEncryptionAtRest(ctx, cr.Spec.ForProvider.ProjectID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEncryption)
+	}
+	kms := config.AWSKMS
+	if kms == nil || !pointer.BoolDeref(kms.Enabled, false) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	valid := pointer.BoolDeref(kms.Valid, false)
+	cr.Status.AtProvider = v1alpha1.ProjectEncryptionAtRestObservation{
+		Enabled:             true,
+		Valid:               valid,
+		CustomerMasterKeyID: kms.CustomerMasterKeyID,
+		Region:              kms.Region,
+		RoleID:              kms.RoleID,
+	}
+	if valid {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(errKeyInvalid))
+	}
+
+	roleID, err := c.roleID(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	want, err := desired(cr.Spec.ForProvider, roleID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: isUpToDate(want, kms)}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectEncryptionAtRest)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEncryptionAtRest)
+	}
+	if err := c.enable(ctx, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.ProjectID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectEncryptionAtRest)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEncryptionAtRest)
+	}
+	return managed.ExternalUpdate{}, c.enable(ctx, cr.Spec.ForProvider)
+}
+
+// Delete disables encryption at rest. Atlas refuses this while clusters of
+// the project still use the key.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectEncryptionAtRest)
+	if !ok {
+		return errors.New(errNotEncryptionAtRest)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := c.client.UpdateEncryptionAtRest(ctx, cr.Spec.ForProvider.ProjectID, svc.EncryptionAtRest{
+		AWSKMS: &svc.AWSKMSConfiguration{Enabled: pointer.Bool(false)},
+	})
+	return errors.Wrap(err, errDisableEncryption)
+}
+
+// enable checks that the role of the spec may use the key and enables
+// encryption at rest with the key.
+func (c *external) enable(ctx context.Context, p v1alpha1.ProjectEncryptionAtRestParameters) error {
+	roleID, err := c.roleID(ctx, p)
+	if err != nil {
+		return err
+	}
+	want, err := desired(p, roleID)
+	if err != nil {
+		return err
+	}
+
+	role, err := c.client.GetCloudProviderAccessRole(ctx, p.ProjectID, roleID)
+	if err != nil {
+		return errors.Wrap(err, errGetRole)
+	}
+	if role.IAMAssumedRoleARN == "" || role.AuthorizedDate == nil {
+		return errors.Errorf(errFmtRoleNotAuthorized, roleID)
+	}
+
+	keys, err := c.newKeyStoreFn(ctx, awsRegion(p))
+	if err != nil {
+		return errors.Wrap(err, errNewKeyStore)
+	}
+	if err := keys.CheckKeyPolicy(ctx, p.KeyARN, role.IAMAssumedRoleARN, keyActions); err != nil {
+		return errors.Wrap(err, errCheckKeyPolicy)
+	}
+
+	_, err = c.client.UpdateEncryptionAtRest(ctx, p.ProjectID, svc.EncryptionAtRest{AWSKMS: want})
+	return errors.Wrap(err, errUpdateEncryption)
+}
+
+// roleID returns the ID of the cloud provider access role of the spec.
+func (c *external) roleID(ctx context.Context, p v1alpha1.ProjectEncryptionAtRestParameters) (string, error) {
+	if p.RoleID != "" {
+		return p.RoleID, nil
+	}
+	if p.RoleRef == nil {
+		return "", errors.New(errNoRole)
+	}
+	return cloudprovideraccessrole.ReferencedID(ctx, c.kube, *p.RoleRef)
+}
+
+// desired returns the AWS KMS configuration of the spec.
+func desired(p v1alpha1.ProjectEncryptionAtRestParameters, roleID string) (*svc.AWSKMSConfiguration, error) {
+	parsed, err := awsarn.Parse(p.KeyARN)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtParseKeyARN, p.KeyARN)
+	}
+	return &svc.AWSKMSConfiguration{
+		Enabled:             pointer.Bool(true),
+		CustomerMasterKeyID: strings.TrimPrefix(parsed.Resource, "key/"),
+		Region:              strings.ToUpper(strings.ReplaceAll(awsRegion(p), "-", "_")),
+		RoleID:              roleID,
+	}, nil
+}
+
+// awsRegion returns the AWS region of the key, e.g. eu-west-1.
+func awsRegion(p v1alpha1.ProjectEncryptionAtRestParameters) string {
+	if p.Region != "" {
+		return p.Region
+	}
+	if parsed, err := awsarn.Parse(p.KeyARN); err == nil {
+		return parsed.Region
+	}
+	return ""
+}
+
+// isUpToDate returns true if Atlas uses the key and role of the spec.
+func isUpToDate(want, got *svc.AWSKMSConfiguration) bool {
+	return got.CustomerMasterKeyID == want.CustomerMasterKeyID &&
+		got.Region == want.Region &&
+		got.RoleID == want.RoleID
+}
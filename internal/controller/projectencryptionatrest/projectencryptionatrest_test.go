@@ -0,0 +1,208 @@
+package projectencryptionatrest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+const (
+	testKeyARN  = "arn:aws:kms:eu-central-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	testKeyID   = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	testRoleARN = "arn:aws:iam::123456789012:role/atlas-kms"
+)
+
+// mockService serves the encryption at rest configuration of a project.
+type mockService struct {
+	svc.Service
+	config  *svc.EncryptionAtRest
+	role    *svc.CloudProviderAccessRole
+	updated *svc.EncryptionAtRest
+}
+
+func (m *mockService) GetEncryptionAtRest(_ context.Context, _ string) (*svc.EncryptionAtRest, error) {
+	return m.config, nil
+}
+
+func (m *mockService) UpdateEncryptionAtRest(_ context.Context, _ string, config svc.EncryptionAtRest) (*svc.EncryptionAtRest, error) {
+	m.updated = &config
+	return &config, nil
+}
+
+func (m *mockService) GetCloudProviderAccessRole(_ context.Context, _, _ string) (*svc.CloudProviderAccessRole, error) {
+	return m.role, nil
+}
+
+// keyStore reports whether the key policy grants the role the key actions.
+type keyStore struct {
+	err error
+}
+
+func (k keyStore) CheckKeyPolicy(_ context.Context, _, _ string, _ []string) error {
+	return k.err
+}
+
+func newEncryption(externalName string) *v1alpha1.ProjectEncryptionAtRest {
+	cr := &v1alpha1.ProjectEncryptionAtRest{ObjectMeta: metav1.ObjectMeta{Name: "encryption"}}
+	meta.SetExternalName(cr, externalName)
+	cr.Spec.ForProvider.ProjectID = "project"
+	cr.Spec.ForProvider.RoleID = "r1"
+	cr.Spec.ForProvider.KeyARN = testKeyARN
+	return cr
+}
+
+func TestDesired(t *testing.T) {
+	tests := []struct {
+		name   string
+		params v1alpha1.ProjectEncryptionAtRestParameters
+		want   *svc.AWSKMSConfiguration
+	}{
+		{
+			name:   "RegionOfKey",
+			params: v1alpha1.ProjectEncryptionAtRestParameters{KeyARN: testKeyARN},
+			want: &svc.AWSKMSConfiguration{
+				Enabled:             pointer.Bool(true),
+				CustomerMasterKeyID: testKeyID,
+				Region:              "EU_CENTRAL_1",
+				RoleID:              "r1",
+			},
+		},
+		{
+			name:   "RegionOverridden",
+			params: v1alpha1.ProjectEncryptionAtRestParameters{KeyARN: testKeyARN, Region: "us-east-1"},
+			want: &svc.AWSKMSConfiguration{
+				Enabled:             pointer.Bool(true),
+				CustomerMasterKeyID: testKeyID,
+				Region:              "US_EAST_1",
+				RoleID:              "r1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := desired(tt.params, "r1")
+			if err != nil {
+				t.Fatalf("desired(...): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("desired(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	tests := []struct {
+		name         string
+		externalName string
+		kms          *svc.AWSKMSConfiguration
+		wantExists   bool
+		wantUpToDate bool
+	}{
+		{
+			name: "NotEnabled",
+		},
+		{
+			name:         "DisabledOutsideCrossplane",
+			externalName: "project",
+			kms:          &svc.AWSKMSConfiguration{Enabled: pointer.Bool(false)},
+		},
+		{
+			name:         "InSync",
+			externalName: "project",
+			kms:          &svc.AWSKMSConfiguration{Enabled: pointer.Bool(true), Valid: pointer.Bool(true), CustomerMasterKeyID: testKeyID, Region: "EU_CENTRAL_1", RoleID: "r1"},
+			wantExists:   true,
+			wantUpToDate: true,
+		},
+		{
+			name:         "OtherKey",
+			externalName: "project",
+			kms:          &svc.AWSKMSConfiguration{Enabled: pointer.Bool(true), Valid: pointer.Bool(true), CustomerMasterKeyID: "other", Region: "EU_CENTRAL_1", RoleID: "r1"},
+			wantExists:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := newEncryption(tt.externalName)
+			e := &external{client: &mockService{config: &svc.EncryptionAtRest{AWSKMS: tt.kms}}, logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	authorized := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		role       *svc.CloudProviderAccessRole
+		policyErr  error
+		wantErr    bool
+		wantUpdate bool
+	}{
+		{
+			name:       "Enabled",
+			role:       &svc.CloudProviderAccessRole{RoleID: "r1", IAMAssumedRoleARN: testRoleARN, AuthorizedDate: &authorized},
+			wantUpdate: true,
+		},
+		{
+			name:    "RoleNotAuthorized",
+			role:    &svc.CloudProviderAccessRole{RoleID: "r1"},
+			wantErr: true,
+		},
+		{
+			name:      "KeyPolicyMissingActions",
+			role:      &svc.CloudProviderAccessRole{RoleID: "r1", IAMAssumedRoleARN: testRoleARN, AuthorizedDate: &authorized},
+			policyErr: errors.New("key policy does not allow kms:Decrypt"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := newEncryption("")
+			m := &mockService{role: tt.role}
+			e := &external{
+				client: m,
+				newKeyStoreFn: func(_ context.Context, _ string) (awsclient.KeyStore, error) {
+					return keyStore{err: tt.policyErr}, nil
+				},
+				logger: logging.NewNopLogger(),
+			}
+
+			_, err := e.Create(context.Background(), cr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (m.updated != nil) != tt.wantUpdate {
+				t.Errorf("Create() enabled encryption = %v, want %v", m.updated != nil, tt.wantUpdate)
+			}
+			if tt.wantUpdate && meta.GetExternalName(cr) != "project" {
+				t.Errorf("Create() external name = %q, want %q", meta.GetExternalName(cr), "project")
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package projectteamassignment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/shared"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/team"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotAssignment = "managed resource is not a ProjectTeamAssignment custom resource"
+	errListTeams     = "cannot list teams of project"
+	errAddTeams      = "cannot assign teams to project"
+
+	errFmtUpdateRoles = "cannot update roles of team %s in project"
+	errFmtRemoveTeam  = "cannot remove team %s from project"
+)
+
+// Setup adds a controller that reconciles ProjectTeamAssignment managed
+// resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectTeamAssignmentGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectTeamAssignmentGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ProjectTeamAssignment{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization that owns
+// the project.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectTeamAssignment)
+	if !ok {
+		return nil, errors.New(errNotAssignment)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	_, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: client, logger: c.logger}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client svc.Service
+	logger logging.Logger
+}
+
+// Observe compares the teams assigned to the project with the spec. The
+// external name is the project ID once the teams were assigned. While the
+// resource is being deleted it exists as long as a team it assigned is still
+// assigned to the project.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectTeamAssignment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAssignment)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	got, err := c.client.ListProjectTeams(ctx, cr.Spec.ForProvider.ProjectID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTeams)
+	}
+	cr.Status.AtProvider.Teams = make([]v1alpha1.ProjectTeamObservation, 0, len(got))
+	for _, t := range got {
+		cr.Status.AtProvider.Teams = append(cr.Status.AtProvider.Teams, v1alpha1.ProjectTeamObservation{TeamID: t.TeamID, Roles: t.RoleNames})
+	}
+
+	// The referenced teams may already be gone during deletion.
+	if meta.WasDeleted(cr) {
+		cr.Status.AtProvider.AppliedTeamIDs = appliedTeams(cr.Status.AtProvider.AppliedTeamIDs, nil, got)
+		return managed.ExternalObservation{ResourceExists: len(cr.Status.AtProvider.AppliedTeamIDs) > 0}, nil
+	}
+
+	want, err := c.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.AppliedTeamIDs = appliedTeams(cr.Status.AtProvider.AppliedTeamIDs, want, got)
+	cr.SetConditions(xpv1.Available())
+
+	add, update, remove := diffTeams(want, got, cr.Status.AtProvider.AppliedTeamIDs)
+	upToDate := len(add) == 0 && len(update) == 0 && len(remove) == 0
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectTeamAssignment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAssignment)
+	}
+	want, err := c.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := c.client.AddProjectTeams(ctx, cr.Spec.ForProvider.ProjectID, want); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAddTeams)
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.ProjectID)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update assigns missing teams, updates roles and removes teams this resource
+// assigned that are no longer in the spec.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectTeamAssignment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAssignment)
+	}
+	projectID := cr.Spec.ForProvider.ProjectID
+
+	got, err := c.client.ListProjectTeams(ctx, projectID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTeams)
+	}
+	want, err := c.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	add, update, remove := diffTeams(want, got, cr.Status.AtProvider.AppliedTeamIDs)
+	if len(add) > 0 {
+		if err := c.client.AddProjectTeams(ctx, projectID, add); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTeams)
+		}
+	}
+	for _, t := range update {
+		if err := c.client.UpdateProjectTeamRoles(ctx, projectID, t.TeamID, t.RoleNames); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errFmtUpdateRoles, t.TeamID)
+		}
+	}
+	for _, id := range remove {
+		if err := c.client.RemoveProjectTeam(ctx, projectID, id); err != nil && !svc.IsNotFoundError(err) {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errFmtRemoveTeam, id)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete removes the teams this resource assigned from the project. It does
+// not need the referenced Teams, which may be deleted first.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectTeamAssignment)
+	if !ok {
+		return errors.New(errNotAssignment)
+	}
+	for _, id := range cr.Status.AtProvider.AppliedTeamIDs {
+		err := c.client.RemoveProjectTeam(ctx, cr.Spec.ForProvider.ProjectID, id)
+		if err != nil && !svc.IsNotFoundError(err) {
+			return errors.Wrapf(err, errFmtRemoveTeam, id)
+		}
+	}
+	return nil
+}
+
+// desired resolves the teams of the spec to their Atlas IDs.
+func (c *external) desired(ctx context.Context, cr *v1alpha1.ProjectTeamAssignment) ([]svc.ProjectTeam, error) {
+	teams := make([]svc.ProjectTeam, 0, len(cr.Spec.ForProvider.Teams))
+	for _, t := range cr.Spec.ForProvider.Teams {
+		id, err := team.ReferencedID(ctx, c.kube, t.TeamRef)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, svc.ProjectTeam{TeamID: id, RoleNames: t.Roles})
+	}
+	return teams, nil
+}
+
+// appliedTeams returns the IDs of the teams assigned to the project that were
+// assigned by the resource: the teams of the spec and the teams it applied
+// before.
+func appliedTeams(previous []string, want, got []svc.ProjectTeam) []string {
+	applied := map[string]bool{}
+	for _, id := range previous {
+		applied[id] = true
+	}
+	for _, t := range want {
+		applied[t.TeamID] = true
+	}
+	var ids []string
+	for _, t := range got {
+		if applied[t.TeamID] {
+			ids = append(ids, t.TeamID)
+		}
+	}
+	return ids
+}
+
+// diffTeams returns the teams to assign to a project, the teams whose roles
+// changed and the IDs of the applied teams to remove from the project.
+func diffTeams(want, got []svc.ProjectTeam, applied []string) ([]svc.ProjectTeam, []svc.ProjectTeam, []string) {
+	current := map[string][]string{}
+	for _, t := range got {
+		current[t.TeamID] = t.RoleNames
+	}
+	wanted := map[string]bool{}
+	var add, update []svc.ProjectTeam
+	for _, t := range want {
+		wanted[t.TeamID] = true
+		roles, ok := current[t.TeamID]
+		switch {
+		case !ok:
+			add = append(add, t)
+		case !shared.SameSet(roles, t.RoleNames):
+			update = append(update, t)
+		}
+	}
+	assigned := map[string]bool{}
+	for _, t := range got {
+		assigned[t.TeamID] = true
+	}
+	var remove []string
+	for _, id := range applied {
+		if assigned[id] && !wanted[id] {
+			remove = append(remove, id)
+		}
+	}
+	return add, update, remove
+}
@@ -0,0 +1,254 @@
+package projectteamassignment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	kubefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	orgv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// mockService serves the teams of a single project.
+type mockService struct {
+	svc.Service
+	teams   []svc.ProjectTeam
+	added   []svc.ProjectTeam
+	removed []string
+}
+
+func (m *mockService) ListProjectTeams(_ context.Context, _ string) ([]svc.ProjectTeam, error) {
+	return m.teams, nil
+}
+
+func (m *mockService) AddProjectTeams(_ context.Context, _ string, teams []svc.ProjectTeam) error {
+	m.added = append(m.added, teams...)
+	return nil
+}
+
+func (m *mockService) UpdateProjectTeamRoles(_ context.Context, _, _ string, _ []string) error {
+	return nil
+}
+
+func (m *mockService) RemoveProjectTeam(_ context.Context, _, teamID string) error {
+	m.removed = append(m.removed, teamID)
+	return nil
+}
+
+// newKube returns a fake API server with Teams named after their IDs.
+func newKube(t *testing.T, ids ...string) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := orgv1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	b := kubefake.NewClientBuilder().WithScheme(scheme)
+	for _, id := range ids {
+		team := &orgv1alpha1.Team{ObjectMeta: metav1.ObjectMeta{Name: id}}
+		meta.SetExternalName(team, id)
+		b = b.WithObjects(team)
+	}
+	return b.Build()
+}
+
+// newAssignment returns an assignment of the supplied teams with the role
+// GROUP_OWNER.
+func newAssignment(ids ...string) *v1alpha1.ProjectTeamAssignment {
+	cr := &v1alpha1.ProjectTeamAssignment{ObjectMeta: metav1.ObjectMeta{Name: "assignment"}}
+	meta.SetExternalName(cr, "project")
+	cr.Spec.ForProvider.ProjectID = "project"
+	for _, id := range ids {
+		cr.Spec.ForProvider.Teams = append(cr.Spec.ForProvider.Teams, v1alpha1.ProjectTeam{
+			TeamRef: xpv1.Reference{Name: id},
+			Roles:   []string{"GROUP_OWNER"},
+		})
+	}
+	return cr
+}
+
+func owner(id string) svc.ProjectTeam {
+	return svc.ProjectTeam{TeamID: id, RoleNames: []string{"GROUP_OWNER"}}
+}
+
+func TestDiffTeams(t *testing.T) {
+	type want struct {
+		add    []svc.ProjectTeam
+		update []svc.ProjectTeam
+		remove []string
+	}
+	tests := []struct {
+		name    string
+		want    []svc.ProjectTeam
+		got     []svc.ProjectTeam
+		applied []string
+		diff    want
+	}{
+		{
+			name: "InSync",
+			want: []svc.ProjectTeam{{TeamID: "a", RoleNames: []string{"GROUP_OWNER", "GROUP_READ_ONLY"}}},
+			got:  []svc.ProjectTeam{{TeamID: "a", RoleNames: []string{"GROUP_READ_ONLY", "GROUP_OWNER"}}},
+		},
+		{
+			name: "RolesChanged",
+			want: []svc.ProjectTeam{{TeamID: "a", RoleNames: []string{"GROUP_OWNER"}}},
+			got:  []svc.ProjectTeam{{TeamID: "a", RoleNames: []string{"GROUP_READ_ONLY"}}},
+			diff: want{update: []svc.ProjectTeam{{TeamID: "a", RoleNames: []string{"GROUP_OWNER"}}}},
+		},
+		{
+			name:    "TeamRemovedFromSpec",
+			want:    []svc.ProjectTeam{owner("a")},
+			got:     []svc.ProjectTeam{owner("a"), owner("b")},
+			applied: []string{"a", "b"},
+			diff:    want{remove: []string{"b"}},
+		},
+		{
+			name:    "TeamAssignedOutsideCrossplane",
+			want:    []svc.ProjectTeam{owner("a")},
+			got:     []svc.ProjectTeam{owner("a"), owner("b")},
+			applied: []string{"a"},
+		},
+		{
+			name:    "AppliedTeamAlreadyRemoved",
+			want:    []svc.ProjectTeam{owner("a")},
+			got:     []svc.ProjectTeam{owner("a")},
+			applied: []string{"a", "b"},
+		},
+		{
+			name: "TeamAdded",
+			want: []svc.ProjectTeam{owner("a")},
+			diff: want{add: []svc.ProjectTeam{owner("a")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, update, remove := diffTeams(tt.want, tt.got, tt.applied)
+			if diff := cmp.Diff(tt.diff, want{add: add, update: update, remove: remove}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("diffTeams(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	deleted := metav1.Now()
+
+	tests := []struct {
+		name         string
+		spec         []string
+		applied      []string
+		deletion     *metav1.Time
+		teams        []svc.ProjectTeam
+		wantExists   bool
+		wantUpToDate bool
+		wantApplied  []string
+	}{
+		{
+			name:         "InSync",
+			spec:         []string{"a"},
+			teams:        []svc.ProjectTeam{owner("a")},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantApplied:  []string{"a"},
+		},
+		{
+			name:         "TeamAssignedOutsideCrossplane",
+			spec:         []string{"a"},
+			applied:      []string{"a"},
+			teams:        []svc.ProjectTeam{owner("a"), owner("b")},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantApplied:  []string{"a"},
+		},
+		{
+			name:         "TeamRemovedFromSpec",
+			spec:         []string{"a"},
+			applied:      []string{"a", "b"},
+			teams:        []svc.ProjectTeam{owner("a"), owner("b")},
+			wantExists:   true,
+			wantUpToDate: false,
+			wantApplied:  []string{"a", "b"},
+		},
+		{
+			// The referenced Team b is gone, which must not block deletion.
+			name:        "Deleting",
+			spec:        []string{"a", "b"},
+			applied:     []string{"a", "b"},
+			deletion:    &deleted,
+			teams:       []svc.ProjectTeam{owner("a")},
+			wantExists:  true,
+			wantApplied: []string{"a"},
+		},
+		{
+			name:     "Deleted",
+			spec:     []string{"a"},
+			applied:  []string{"a"},
+			deletion: &deleted,
+			teams:    []svc.ProjectTeam{owner("b")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := newAssignment(tt.spec...)
+			cr.SetDeletionTimestamp(tt.deletion)
+			cr.Status.AtProvider.AppliedTeamIDs = tt.applied
+			e := &external{kube: newKube(t, "a"), client: &mockService{teams: tt.teams}, logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+			if diff := cmp.Diff(tt.wantApplied, cr.Status.AtProvider.AppliedTeamIDs); diff != "" {
+				t.Errorf("Observe() AppliedTeamIDs -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cr := newAssignment("a", "c")
+	cr.Status.AtProvider.AppliedTeamIDs = []string{"a", "b"}
+	m := &mockService{teams: []svc.ProjectTeam{owner("a"), owner("b"), owner("outside")}}
+	e := &external{kube: newKube(t, "a", "c"), client: m, logger: logging.NewNopLogger()}
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if diff := cmp.Diff([]svc.ProjectTeam{owner("c")}, m.added); diff != "" {
+		t.Errorf("Update() added -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"b"}, m.removed); diff != "" {
+		t.Errorf("Update() removed -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	// The Teams are deleted already.
+	cr := newAssignment("a", "b")
+	cr.Status.AtProvider.AppliedTeamIDs = []string{"a", "b"}
+	m := &mockService{}
+	e := &external{kube: newKube(t), client: m, logger: logging.NewNopLogger()}
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, m.removed); diff != "" {
+		t.Errorf("Delete() removed -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,211 @@
+package serverlessinstance
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	apisv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/features"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/shared"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotServerlessInstance = "managed resource is not a ServerlessInstance custom resource"
+	errGetInstance           = "cannot get serverless instance"
+	errCreateInstance        = "cannot create serverless instance"
+	errUpdateInstance        = "cannot update serverless instance"
+	errDeleteInstance        = "cannot delete serverless instance"
+)
+
+// Setup adds a controller that reconciles ServerlessInstance managed
+// resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ServerlessInstanceGroupKind)
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ServerlessInstanceGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ServerlessInstance{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization that owns
+// the project of the instance.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ServerlessInstance)
+	if !ok {
+		return nil, errors.New(errNotServerlessInstance)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	_, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: client, logger: c.logger}, nil
+}
+
+type external struct {
+	client svc.Service
+	logger logging.Logger
+}
+
+// instanceName returns the name of the instance in Atlas.
+func instanceName(cr *v1alpha1.ServerlessInstance) string {
+	if name := meta.GetExternalName(cr); name != "" {
+		return name
+	}
+	if cr.Spec.ForProvider.Name != "" {
+		return cr.Spec.ForProvider.Name
+	}
+	return cr.Name
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServerlessInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServerlessInstance)
+	}
+	instance, err := c.client.GetServerlessInstance(ctx, cr.Spec.ForProvider.ProjectID, instanceName(cr))
+	if svc.IsNotFoundError(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetInstance)
+	}
+
+	cr.Status.AtProvider.ID = instance.ID
+	cr.Status.AtProvider.StateName = instance.StateName
+	cr.Status.AtProvider.MongoDBVersion = instance.MongoDBVersion
+	cr.SetConditions(shared.ClusterCondition(instance.StateName))
+
+	details := managed.ConnectionDetails{}
+	if instance.ConnectionStrings != nil && instance.ConnectionStrings.StandardSrv != "" {
+		details["srvConnectionString"] = []byte(instance.ConnectionStrings.StandardSrv)
+	}
+
+	// Atlas rejects updates while the instance is changing.
+	upToDate := instance.StateName != svc.ClusterStateIdle || isUpToDate(cr.Spec.ForProvider, instance)
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate, ConnectionDetails: details}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServerlessInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServerlessInstance)
+	}
+	p := cr.Spec.ForProvider
+	name := instanceName(cr)
+	_, err := c.client.CreateServerlessInstance(ctx, p.ProjectID, svc.ServerlessInstance{
+		Name: name,
+		ProviderSettings: &svc.ServerlessProviderSettings{
+			ProviderName:        "SERVERLESS",
+			BackingProviderName: p.ProviderName,
+			RegionName:          p.RegionName,
+		},
+		ServerlessBackupOptions:      &svc.ServerlessBackupOptions{ServerlessContinuousBackupEnabled: pointer.Bool(p.ContinuousBackupEnabled)},
+		TerminationProtectionEnabled: pointer.Bool(p.TerminationProtectionEnabled),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+	}
+	meta.SetExternalName(cr, name)
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, nil
+}
+
+// Update changes the backup options and termination protection. The provider
+// and region of an instance cannot be changed.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServerlessInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServerlessInstance)
+	}
+	p := cr.Spec.ForProvider
+	_, err := c.client.UpdateServerlessInstance(ctx, p.ProjectID, instanceName(cr), svc.ServerlessInstance{
+		ServerlessBackupOptions:      &svc.ServerlessBackupOptions{ServerlessContinuousBackupEnabled: pointer.Bool(p.ContinuousBackupEnabled)},
+		TerminationProtectionEnabled: pointer.Bool(p.TerminationProtectionEnabled),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServerlessInstance)
+	if !ok {
+		return errors.New(errNotServerlessInstance)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.StateName == svc.ClusterStateDeleting {
+		return nil
+	}
+	err := c.client.DeleteServerlessInstance(ctx, cr.Spec.ForProvider.ProjectID, instanceName(cr))
+	if err != nil && !svc.IsNotFoundError(err) {
+		return errors.Wrap(err, errDeleteInstance)
+	}
+	return nil
+}
+
+// isUpToDate returns true if the updatable settings of the instance match
+// the spec.
+func isUpToDate(p v1alpha1.ServerlessInstanceParameters, instance *svc.ServerlessInstance) bool {
+	if pointer.BoolDeref(instance.TerminationProtectionEnabled, false) != p.TerminationProtectionEnabled {
+		return false
+	}
+	backup := false
+	if instance.ServerlessBackupOptions != nil {
+		backup = pointer.BoolDeref(instance.ServerlessBackupOptions.ServerlessContinuousBackupEnabled, false)
+	}
+	return backup == p.ContinuousBackupEnabled
+}
@@ -0,0 +1,144 @@
+package serverlessinstance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/cluster/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// mockService serves a single serverless instance.
+type mockService struct {
+	svc.Service
+	instance *svc.ServerlessInstance
+	updated  *svc.ServerlessInstance
+}
+
+func (m *mockService) GetServerlessInstance(_ context.Context, _, _ string) (*svc.ServerlessInstance, error) {
+	if m.instance == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.instance, nil
+}
+
+func (m *mockService) UpdateServerlessInstance(_ context.Context, _, _ string, instance svc.ServerlessInstance) (*svc.ServerlessInstance, error) {
+	m.updated = &instance
+	return &instance, nil
+}
+
+func TestObserve(t *testing.T) {
+	backup := &svc.ServerlessBackupOptions{ServerlessContinuousBackupEnabled: pointer.Bool(true)}
+
+	tests := []struct {
+		name         string
+		instance     *svc.ServerlessInstance
+		params       v1alpha1.ServerlessInstanceParameters
+		wantExists   bool
+		wantUpToDate bool
+		wantReady    corev1.ConditionStatus
+		wantReason   xpv1.ConditionReason
+	}{
+		{
+			name: "NotFound",
+		},
+		{
+			name:         "Available",
+			instance:     &svc.ServerlessInstance{StateName: svc.ClusterStateIdle, ServerlessBackupOptions: backup},
+			params:       v1alpha1.ServerlessInstanceParameters{ContinuousBackupEnabled: true},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantReady:    corev1.ConditionTrue,
+			wantReason:   xpv1.ReasonAvailable,
+		},
+		{
+			name:         "TerminationProtectionDrifted",
+			instance:     &svc.ServerlessInstance{StateName: svc.ClusterStateIdle, ServerlessBackupOptions: backup},
+			params:       v1alpha1.ServerlessInstanceParameters{ContinuousBackupEnabled: true, TerminationProtectionEnabled: true},
+			wantExists:   true,
+			wantUpToDate: false,
+			wantReady:    corev1.ConditionTrue,
+			wantReason:   xpv1.ReasonAvailable,
+		},
+		{
+			name:         "BackupDrifted",
+			instance:     &svc.ServerlessInstance{StateName: svc.ClusterStateIdle},
+			params:       v1alpha1.ServerlessInstanceParameters{ContinuousBackupEnabled: true},
+			wantExists:   true,
+			wantUpToDate: false,
+			wantReady:    corev1.ConditionTrue,
+			wantReason:   xpv1.ReasonAvailable,
+		},
+		{
+			// Atlas rejects updates while the instance is changing.
+			name:         "Updating",
+			instance:     &svc.ServerlessInstance{StateName: svc.ClusterStateUpdating},
+			params:       v1alpha1.ServerlessInstanceParameters{TerminationProtectionEnabled: true},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantReady:    corev1.ConditionFalse,
+			wantReason:   xpv1.ReasonUnavailable,
+		},
+		{
+			name:         "Deleting",
+			instance:     &svc.ServerlessInstance{StateName: svc.ClusterStateDeleting},
+			wantExists:   true,
+			wantUpToDate: true,
+			wantReady:    corev1.ConditionFalse,
+			wantReason:   xpv1.ReasonDeleting,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.ServerlessInstance{ObjectMeta: metav1.ObjectMeta{Name: "serverless"}}
+			cr.Spec.ForProvider = tt.params
+			e := &external{client: &mockService{instance: tt.instance}, logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if !tt.wantExists {
+				return
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+			ready := cr.GetCondition(xpv1.TypeReady)
+			if ready.Status != tt.wantReady || ready.Reason != tt.wantReason {
+				t.Errorf("Observe() Ready = %s/%s, want %s/%s", ready.Status, ready.Reason, tt.wantReady, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cr := &v1alpha1.ServerlessInstance{ObjectMeta: metav1.ObjectMeta{Name: "serverless"}}
+	meta.SetExternalName(cr, "serverless")
+	cr.Spec.ForProvider.TerminationProtectionEnabled = true
+	m := &mockService{}
+
+	if _, err := (&external{client: m, logger: logging.NewNopLogger()}).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	want := &svc.ServerlessInstance{
+		ServerlessBackupOptions:      &svc.ServerlessBackupOptions{ServerlessContinuousBackupEnabled: pointer.Bool(false)},
+		TerminationProtectionEnabled: pointer.Bool(true),
+	}
+	if diff := cmp.Diff(want, m.updated); diff != "" {
+		t.Errorf("Update() -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,56 @@
+// Package shared contains helpers used by the controllers of several Atlas
+// resources.
+package shared
+
+import (
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// SameSet returns true if a and b contain the same strings, regardless of
+// their order.
+func SameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// MetaTime converts an optional time reported by Atlas.
+func MetaTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	mt := metav1.NewTime(*t)
+	return &mt
+}
+
+// ClusterCondition maps the state of an Atlas cluster or serverless instance
+// to a condition.
+func ClusterCondition(state string) xpv1.Condition {
+	switch state {
+	case svc.ClusterStateIdle:
+		return xpv1.Available()
+	case svc.ClusterStateCreating:
+		return xpv1.Creating()
+	case svc.ClusterStateDeleting:
+		return xpv1.Deleting()
+	default:
+		return xpv1.Unavailable().WithMessage(state)
+	}
+}
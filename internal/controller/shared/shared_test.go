@@ -0,0 +1,43 @@
+package shared
+
+import (
+	"testing"
+)
+
+func TestSameSet(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want bool
+	}{
+		{
+			name: "Empty",
+			want: true,
+		},
+		{
+			name: "DifferentOrder",
+			a:    []string{"ORG_MEMBER", "ORG_BILLING_ADMIN"},
+			b:    []string{"ORG_BILLING_ADMIN", "ORG_MEMBER"},
+			want: true,
+		},
+		{
+			name: "Missing",
+			a:    []string{"ORG_MEMBER", "ORG_BILLING_ADMIN"},
+			b:    []string{"ORG_MEMBER"},
+		},
+		{
+			name: "Different",
+			a:    []string{"ORG_MEMBER"},
+			b:    []string{"ORG_OWNER"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SameSet(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
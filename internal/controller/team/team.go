@@ -0,0 +1,259 @@
+package team
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/controller/organization"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
+)
+
+const (
+	errNotTeam     = "managed resource is not a Team custom resource"
+	errGetTeam     = "cannot get team"
+	errCreateTeam  = "cannot create team"
+	errRenameTeam  = "cannot rename team"
+	errDeleteTeam  = "cannot delete team"
+	errListMembers = "cannot list members of team"
+	errAddMembers  = "cannot add members to team"
+
+	errFmtGetUser      = "cannot get user %s"
+	errFmtRemoveMember = "cannot remove %s from team"
+	errFmtGetTeamRef   = "cannot get Team %s"
+	errFmtTeamNotReady = "Team %s has not been created yet"
+)
+
+// Setup adds a controller that reconciles Team managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TeamGroupKind)
+
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:      mgr.GetClient(),
+			logger:    o.Logger,
+			connectFn: organization.ConnectReferenced,
+		}),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TeamGroupVersionKind),
+		opts...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Team{}).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube      client.Client
+	logger    logging.Logger
+	connectFn func(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, svc.Service, error)
+}
+
+// Connect connects to Atlas with the API key of the organization of the team.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return nil, errors.New(errNotTeam)
+	}
+	tracing.AnnotateResource(ctx, mg)
+
+	orgID, client, err := c.connectFn(ctx, c.kube, cr.Spec.ForProvider.OrganizationRef)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: client, orgID: orgID, logger: c.logger}, nil
+}
+
+type external struct {
+	client svc.Service
+	orgID  string
+	logger logging.Logger
+}
+
+// ReferencedID returns the Atlas ID of the referenced Team.
+func ReferencedID(ctx context.Context, kube client.Client, ref xpv1.Reference) (string, error) {
+	cr := &v1alpha1.Team{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name}, cr); err != nil {
+		return "", errors.Wrapf(err, errFmtGetTeamRef, ref.Name)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return "", errors.Errorf(errFmtTeamNotReady, ref.Name)
+	}
+	return id, nil
+}
+
+// teamName returns the name of the team in Atlas.
+func teamName(cr *v1alpha1.Team) string {
+	if cr.Spec.ForProvider.Name != "" {
+		return cr.Spec.ForProvider.Name
+	}
+	return cr.Name
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTeam)
+	}
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	team, err := c.client.GetTeam(ctx, c.orgID, id)
+	if svc.IsNotFoundError(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTeam)
+	}
+	members, err := c.client.ListTeamUsers(ctx, c.orgID, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListMembers)
+	}
+
+	cr.Status.AtProvider.ID = team.ID
+	cr.Status.AtProvider.OrgID = c.orgID
+	cr.Status.AtProvider.Usernames = usernames(members)
+	cr.SetConditions(xpv1.Available())
+
+	add, remove := diffMembers(cr.Spec.ForProvider.Usernames, members)
+	if len(add) > 0 || len(remove) > 0 {
+		c.logger.Debug("Team members changed outside of Crossplane", "team", id, "missing", add, "unexpected", usernames(remove))
+	}
+	upToDate := team.Name == teamName(cr) && len(add) == 0 && len(remove) == 0
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: upToDate}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTeam)
+	}
+	team, err := c.client.CreateTeam(ctx, c.orgID, svc.Team{Name: teamName(cr), Usernames: cr.Spec.ForProvider.Usernames})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTeam)
+	}
+	meta.SetExternalName(cr, team.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update renames the team and adds and removes members to match the spec.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTeam)
+	}
+	id := meta.GetExternalName(cr)
+
+	team, err := c.client.GetTeam(ctx, c.orgID, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTeam)
+	}
+	if name := teamName(cr); team.Name != name {
+		if err := c.client.RenameTeam(ctx, c.orgID, id, name); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRenameTeam)
+		}
+	}
+
+	members, err := c.client.ListTeamUsers(ctx, c.orgID, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListMembers)
+	}
+	add, remove := diffMembers(cr.Spec.ForProvider.Usernames, members)
+	userIDs := make([]string, 0, len(add))
+	for _, username := range add {
+		user, err := c.client.GetUserByName(ctx, username)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errFmtGetUser, username)
+		}
+		userIDs = append(userIDs, user.ID)
+	}
+	if len(userIDs) > 0 {
+		if err := c.client.AddTeamUsers(ctx, c.orgID, id, userIDs); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddMembers)
+		}
+	}
+	for _, user := range remove {
+		if err := c.client.RemoveTeamUser(ctx, c.orgID, id, user.ID); err != nil && !svc.IsNotFoundError(err) {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errFmtRemoveMember, user.Username)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return errors.New(errNotTeam)
+	}
+	err := c.client.DeleteTeam(ctx, c.orgID, meta.GetExternalName(cr))
+	if err != nil && !svc.IsNotFoundError(err) {
+		return errors.Wrap(err, errDeleteTeam)
+	}
+	return nil
+}
+
+// diffMembers returns the usernames missing from a team and the members of
+// the team that are not wanted. Usernames are compared case insensitively.
+func diffMembers(want []string, got []svc.User) ([]string, []svc.User) {
+	wanted := map[string]bool{}
+	for _, u := range want {
+		wanted[strings.ToLower(u)] = true
+	}
+	present := map[string]bool{}
+	var remove []svc.User
+	for _, u := range got {
+		present[strings.ToLower(u.Username)] = true
+		if !wanted[strings.ToLower(u.Username)] {
+			remove = append(remove, u)
+		}
+	}
+	var add []string
+	for _, u := range want {
+		if !present[strings.ToLower(u)] {
+			add = append(add, u)
+			present[strings.ToLower(u)] = true
+		}
+	}
+	return add, remove
+}
+
+// usernames returns the sorted usernames of users.
+func usernames(users []svc.User) []string {
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		names = append(names, u.Username)
+	}
+	sort.Strings(names)
+	return names
+}
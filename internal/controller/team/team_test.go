@@ -0,0 +1,173 @@
+package team
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/mongodb"
+)
+
+// mockService serves a single team.
+type mockService struct {
+	svc.Service
+	team    *svc.Team
+	members []svc.User
+	renamed string
+	added   []string
+	removed []string
+}
+
+func (m *mockService) GetTeam(_ context.Context, _, _ string) (*svc.Team, error) {
+	if m.team == nil {
+		return nil, &svc.NotFoundError{}
+	}
+	return m.team, nil
+}
+
+func (m *mockService) ListTeamUsers(_ context.Context, _, _ string) ([]svc.User, error) {
+	return m.members, nil
+}
+
+func (m *mockService) RenameTeam(_ context.Context, _, _, name string) error {
+	m.renamed = name
+	return nil
+}
+
+func (m *mockService) GetUserByName(_ context.Context, username string) (*svc.User, error) {
+	return &svc.User{ID: "id-" + username, Username: username}, nil
+}
+
+func (m *mockService) AddTeamUsers(_ context.Context, _, _ string, userIDs []string) error {
+	m.added = append(m.added, userIDs...)
+	return nil
+}
+
+func (m *mockService) RemoveTeamUser(_ context.Context, _, _, userID string) error {
+	m.removed = append(m.removed, userID)
+	return nil
+}
+
+func newTeam(usernames ...string) *v1alpha1.Team {
+	cr := &v1alpha1.Team{ObjectMeta: metav1.ObjectMeta{Name: "admins"}}
+	meta.SetExternalName(cr, "team-id")
+	cr.Spec.ForProvider.Usernames = usernames
+	return cr
+}
+
+func TestDiffMembers(t *testing.T) {
+	tests := []struct {
+		name       string
+		want       []string
+		got        []svc.User
+		wantAdd    []string
+		wantRemove []svc.User
+	}{
+		{
+			name: "InSync",
+			want: []string{"Jane.Doe@example.com"},
+			got:  []svc.User{{ID: "1", Username: "jane.doe@example.com"}},
+		},
+		{
+			name:       "AddedOutsideCrossplane",
+			want:       []string{"jane.doe@example.com"},
+			got:        []svc.User{{ID: "1", Username: "jane.doe@example.com"}, {ID: "2", Username: "john.doe@example.com"}},
+			wantRemove: []svc.User{{ID: "2", Username: "john.doe@example.com"}},
+		},
+		{
+			name:    "RemovedOutsideCrossplane",
+			want:    []string{"jane.doe@example.com", "john.doe@example.com"},
+			got:     []svc.User{{ID: "1", Username: "jane.doe@example.com"}},
+			wantAdd: []string{"john.doe@example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := diffMembers(tt.want, tt.got)
+			if diff := cmp.Diff(tt.wantAdd, add); diff != "" {
+				t.Errorf("diffMembers(...): -want add, +got add:\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRemove, remove); diff != "" {
+				t.Errorf("diffMembers(...): -want remove, +got remove:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	tests := []struct {
+		name         string
+		team         *svc.Team
+		members      []svc.User
+		wantExists   bool
+		wantUpToDate bool
+	}{
+		{
+			name: "NotFound",
+		},
+		{
+			name:         "InSync",
+			team:         &svc.Team{ID: "team-id", Name: "admins"},
+			members:      []svc.User{{ID: "1", Username: "jane.doe@example.com"}},
+			wantExists:   true,
+			wantUpToDate: true,
+		},
+		{
+			name:       "Renamed",
+			team:       &svc.Team{ID: "team-id", Name: "old"},
+			members:    []svc.User{{ID: "1", Username: "jane.doe@example.com"}},
+			wantExists: true,
+		},
+		{
+			name:       "MemberAddedOutsideCrossplane",
+			team:       &svc.Team{ID: "team-id", Name: "admins"},
+			members:    []svc.User{{ID: "1", Username: "jane.doe@example.com"}, {ID: "2", Username: "john.doe@example.com"}},
+			wantExists: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := newTeam("jane.doe@example.com")
+			e := &external{client: &mockService{team: tt.team, members: tt.members}, orgID: "org", logger: logging.NewNopLogger()}
+
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe() error = %v", err)
+			}
+			if obs.ResourceExists != tt.wantExists {
+				t.Errorf("Observe() ResourceExists = %v, want %v", obs.ResourceExists, tt.wantExists)
+			}
+			if obs.ResourceUpToDate != tt.wantUpToDate {
+				t.Errorf("Observe() ResourceUpToDate = %v, want %v", obs.ResourceUpToDate, tt.wantUpToDate)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cr := newTeam("jane.doe@example.com", "new.member@example.com")
+	m := &mockService{
+		team:    &svc.Team{ID: "team-id", Name: "old"},
+		members: []svc.User{{ID: "1", Username: "jane.doe@example.com"}, {ID: "2", Username: "john.doe@example.com"}},
+	}
+
+	if _, err := (&external{client: m, orgID: "org", logger: logging.NewNopLogger()}).Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if m.renamed != "admins" {
+		t.Errorf("Update() renamed = %q, want %q", m.renamed, "admins")
+	}
+	if diff := cmp.Diff([]string{"id-new.member@example.com"}, m.added); diff != "" {
+		t.Errorf("Update() added -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"2"}, m.removed); diff != "" {
+		t.Errorf("Update() removed -want, +got:\n%s", diff)
+	}
+}
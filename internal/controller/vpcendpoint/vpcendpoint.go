@@ -11,6 +11,7 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -21,6 +22,7 @@ import (
 	apisv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/v1alpha1"
 	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
 	svc "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/connectivity"
+	"github.com/svchaudhari/Swap-Provider-MongoDB/internal/tracing"
 )
 
 const (
@@ -35,17 +37,24 @@ const (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.VPCEndpointGroupKind)
 
-	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.VPCEndpointGroupVersionKind),
+	opts := []managed.ReconcilerOption{
 		managed.WithExternalConnecter(&connector{
-			kube:          mgr.GetClient(),
-			usage:         resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
-			logger:        o.Logger,
-			newAWSClientFn: aws.NewClient,
+			kube:           mgr.GetClient(),
+			usage:          resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			logger:         o.Logger,
+			newAWSClientFn: aws.NewSecretStore,
 		}),
 		managed.WithInitializers(),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if o.Features.Enabled(feature.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.VPCEndpointGroupVersionKind),
+		opts...,
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -53,14 +62,14 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1alpha1.VPCEndpoint{}).
 		WithEventFilter(resource.DesiredStateChanged()).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(name, r), o.GlobalRateLimiter))
 }
 
 type connector struct {
 	kube          client.Client
 	usage         resource.Tracker
 	logger        logging.Logger
-	newAWSClientFn func(ctx context.Context, region string) (*aws.Client, error)
+	newAWSClientFn func(ctx context.Context, region string) (aws.SecretStore, error)
 }
 
 // Connect produces an ExternalClient using AWS-only credentials
@@ -70,6 +79,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.New(errNotVPCEndpoint)
 	}
 
+	tracing.AnnotateResource(ctx, mg)
 	if err := c.usage.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
@@ -188,6 +198,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	c.logger.Debug("VPCEndpoint was created successfully", "id", res.VpcEndpoint.VpcEndpointID)
 	meta.SetExternalName(cr, res.VpcEndpoint.VpcEndpointID)
+	tracing.AnnotateResource(ctx, cr)
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
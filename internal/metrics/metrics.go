@@ -0,0 +1,68 @@
+// Package metrics exposes Prometheus metrics for calls made to external APIs
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Services that the provider talks to.
+const (
+	ServiceAtlas          = "atlas"
+	ServiceSecretsManager = "secretsmanager"
+	ServiceKMS            = "kms"
+	ServiceConnectivity   = "connectivity"
+	ServiceVault          = "vault"
+)
+
+// Error types used to classify failed requests.
+const (
+	ErrorTypeNone      = "none"
+	ErrorTypeRetryable = "retryable"
+	ErrorTypeConflict  = "conflict"
+	ErrorTypeNotFound  = "not_found"
+	ErrorTypeOther     = "other"
+)
+
+const statusClassNone = "none"
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "provider_mongodb",
+		Subsystem: "external",
+		Name:      "requests_total",
+		Help:      "Total number of requests made to external APIs.",
+	}, []string{"service", "operation", "status_class", "error_type"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "provider_mongodb",
+		Subsystem: "external",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests made to external APIs.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "operation", "status_class", "error_type"})
+)
+
+func init() {
+	// controller-runtime serves this registry on the manager's /metrics endpoint
+	metrics.Registry.MustRegister(requestsTotal, requestDuration)
+}
+
+// StatusClass maps an HTTP status code to its class (e.g. "2xx"). A zero
+// status code means no response was received.
+func StatusClass(statusCode int) string {
+	if statusCode <= 0 {
+		return statusClassNone
+	}
+	return fmt.Sprintf("%dxx", statusCode/100)
+}
+
+// ObserveRequest records a single request to an external API.
+func ObserveRequest(service, operation string, statusCode int, errorType string, duration time.Duration) {
+	class := StatusClass(statusCode)
+	requestsTotal.WithLabelValues(service, operation, class, errorType).Inc()
+	requestDuration.WithLabelValues(service, operation, class, errorType).Observe(duration.Seconds())
+}
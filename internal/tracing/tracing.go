@@ -0,0 +1,147 @@
+// Package tracing provides OpenTelemetry tracing for reconciles and calls made
+// to external APIs
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	xpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	tracerName  = "github.com/svchaudhari/Swap-Provider-MongoDB"
+	serviceName = "provider-mongodb"
+)
+
+// Attribute keys used to tag spans.
+const (
+	AttrController     = attribute.Key("crossplane.controller")
+	AttrResourceName   = attribute.Key("crossplane.resource.name")
+	AttrExternalName   = attribute.Key("crossplane.resource.external_name")
+	AttrProviderConfig = attribute.Key("crossplane.resource.provider_config")
+	AttrService        = attribute.Key("external.service")
+	AttrOperation      = attribute.Key("external.operation")
+	AttrStatusCode     = attribute.Key("http.status_code")
+)
+
+// Options configures trace export.
+type Options struct {
+	// Endpoint is the host:port of an OTLP gRPC collector.
+	Endpoint string
+	// Insecure disables TLS towards the collector.
+	Insecure bool
+	// SampleRatio is the fraction of root spans that are sampled.
+	SampleRatio float64
+}
+
+// Setup installs a global tracer provider that exports spans to an OTLP
+// collector. The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, o Options) (func(context.Context) error, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(o.Endpoint)}
+	if o.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create OTLP trace exporter")
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create trace resource")
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(o.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+// Tracer returns the provider's tracer. Spans are no-ops unless Setup was
+// called.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// StartSpan starts a child span for a call to an external service.
+func StartSpan(ctx context.Context, service, operation string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, service+"."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(AttrService.String(service), AttrOperation.String(operation)),
+	)
+}
+
+// EndSpan records the outcome of an external call and ends the span. A zero
+// status code means no response was received.
+func EndSpan(span trace.Span, statusCode int, err error) {
+	if statusCode > 0 {
+		span.SetAttributes(AttrStatusCode.Int(statusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else if statusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(statusCode))
+	}
+	span.End()
+}
+
+// AnnotateResource tags the current span with the identity of a managed
+// resource.
+func AnnotateResource(ctx context.Context, mg xpresource.Managed) {
+	attrs := []attribute.KeyValue{AttrResourceName.String(mg.GetName())}
+	if en := meta.GetExternalName(mg); en != "" {
+		attrs = append(attrs, AttrExternalName.String(en))
+	}
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		attrs = append(attrs, AttrProviderConfig.String(ref.Name))
+	}
+	trace.SpanFromContext(ctx).SetAttributes(attrs...)
+}
+
+// Reconciler starts a span for every reconcile of the wrapped reconciler.
+type Reconciler struct {
+	name  string
+	inner reconcile.Reconciler
+}
+
+// NewReconciler wraps a reconciler so that each reconcile is traced.
+func NewReconciler(name string, r reconcile.Reconciler) *Reconciler {
+	return &Reconciler{name: name, inner: r}
+}
+
+// Reconcile runs the wrapped reconciler inside a new span.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, span := Tracer().Start(ctx, "Reconcile "+r.name, trace.WithAttributes(
+		AttrController.String(r.name),
+		AttrResourceName.String(req.Name),
+	))
+	defer span.End()
+
+	result, err := r.inner.Reconcile(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
@@ -0,0 +1,324 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	awsclient "github.com/svchaudhari/Swap-Provider-MongoDB/internal/clients/aws"
+)
+
+const (
+	errNotOrganization = "object is not an Organization"
+
+	// AWS limits secret names to 512 characters, and the controller
+	// prepends "product/mongodb/" by default.
+	maxSecretNameLength = 512 - len("product/mongodb/")
+
+	// AWS tag limits.
+	maxTagKeyLength   = 128
+	maxTagValueLength = 256
+)
+
+var (
+	// Atlas user IDs are 24 character hexadecimal object IDs.
+	ownerIDRegexp = regexp.MustCompile(`^[0-9a-f]{24}$`)
+	// Secrets Manager allows alphanumerics and /_+=.@- in secret names.
+	secretNameRegexp = regexp.MustCompile(`^[A-Za-z0-9/_+=.@-]+$`)
+	emailRegexp      = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+	organizationRoles = map[string]bool{
+		"ORG_OWNER":             true,
+		"ORG_MEMBER":            true,
+		"ORG_GROUP_CREATOR":     true,
+		"ORG_BILLING_ADMIN":     true,
+		"ORG_BILLING_READ_ONLY": true,
+		"ORG_READ_ONLY":         true,
+	}
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-organization-mongodb-allianz-io-v1alpha1-organization,mutating=false,failurePolicy=fail,groups=organization.mongodb.allianz.io,resources=organizations,versions=v1alpha1,name=organizations.organization.mongodb.allianz.io,sideEffects=None,admissionReviewVersions=v1
+
+type organizationValidator struct{}
+
+func (v *organizationValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*v1alpha1.Organization)
+	if !ok {
+		return nil, errors.New(errNotOrganization)
+	}
+	return nil, toInvalid(v1alpha1.OrganizationGroupVersionKind.GroupKind(), cr.GetName(), validateOrganization(cr))
+}
+
+// ValidateUpdate only rejects errors introduced by the update. Updates that
+// don't change the spec, e.g. removing a finalizer of an organization that is
+// being deleted, are allowed even if the spec no longer passes validation.
+func (v *organizationValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldCR, ok := oldObj.(*v1alpha1.Organization)
+	if !ok {
+		return nil, errors.New(errNotOrganization)
+	}
+	cr, ok := newObj.(*v1alpha1.Organization)
+	if !ok {
+		return nil, errors.New(errNotOrganization)
+	}
+	if meta.WasDeleted(cr) || equality.Semantic.DeepEqual(oldCR.Spec, cr.Spec) {
+		return nil, nil
+	}
+	errs := introducedErrors(validateOrganization(oldCR), validateOrganization(cr))
+	errs = append(errs, validateOrganizationImmutable(oldCR, cr)...)
+	return nil, toInvalid(v1alpha1.OrganizationGroupVersionKind.GroupKind(), cr.GetName(), errs)
+}
+
+func (v *organizationValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateOrganization(cr *v1alpha1.Organization) field.ErrorList {
+	var errs field.ErrorList
+	p := field.NewPath("spec", "forProvider")
+
+	create := createAllowed(cr)
+	switch ownerID := cr.Spec.ForProvider.OwnerID; {
+	case ownerID == "" && create:
+		errs = append(errs, field.Required(p.Child("ownerID"), "ownerID is required"))
+	case ownerID != "" && !ownerIDRegexp.MatchString(ownerID):
+		errs = append(errs, field.Invalid(p.Child("ownerID"), ownerID, "must be a 24 character hexadecimal Atlas user ID"))
+	}
+
+	errs = append(errs, validateAPIKey(cr.Spec.ForProvider.APIKey, create, p.Child("apiKey"))...)
+
+	if settings := cr.Spec.ForProvider.Settings; settings != nil && settings.SecurityContact != nil {
+		if contact := *settings.SecurityContact; contact != "" && !emailRegexp.MatchString(contact) {
+			errs = append(errs, field.Invalid(p.Child("settings", "securityContact"), contact, "must be an email address"))
+		}
+	}
+
+	if cr.Spec.ForProvider.CredentialSink == v1alpha1.CredentialSinkVault {
+		vp := p.Child("vaultConfig")
+		switch vault := cr.Spec.ForProvider.VaultConfig; {
+		case vault == nil:
+			errs = append(errs, field.Required(vp, "vaultConfig is required when credentialSink is Vault"))
+		case vault.StoreConfigRef.Name == "":
+			errs = append(errs, field.Required(vp.Child("storeConfigRef", "name"), ""))
+		}
+		return append(errs, validateSecretPayload(cr.Spec.ForProvider.SecretPayload, p.Child("secretPayload"))...)
+	}
+
+	secrets := cr.Spec.ForProvider.AWSSecretsConfig
+	sp := p.Child("awsSecretsConfig")
+	errs = append(errs, validateRegion(secrets.Region, sp.Child("region"))...)
+	if secrets.SecretName != nil && *secrets.SecretName != "" {
+		name := *secrets.SecretName
+		switch {
+		case !secretNameRegexp.MatchString(name):
+			errs = append(errs, field.Invalid(sp.Child("secretName"), name, "may only contain alphanumeric characters and /_+=.@-"))
+		case len(name) > maxSecretNameLength:
+			errs = append(errs, field.TooLong(sp.Child("secretName"), name, maxSecretNameLength))
+		}
+	}
+	seen := map[string]bool{secrets.Region: true}
+	for i, r := range secrets.ReplicaRegions {
+		rp := sp.Child("replicaRegions").Index(i).Child("region")
+		errs = append(errs, validateRegion(r.Region, rp)...)
+		if seen[r.Region] {
+			errs = append(errs, field.Duplicate(rp, r.Region))
+		}
+		seen[r.Region] = true
+	}
+	errs = append(errs, validateSecretTags(secrets.Tags, sp.Child("tags"))...)
+	if secrets.ResourcePolicy != nil && !json.Valid([]byte(*secrets.ResourcePolicy)) {
+		errs = append(errs, field.Invalid(sp.Child("resourcePolicy"), *secrets.ResourcePolicy, "must be a JSON policy document"))
+	}
+	errs = append(errs, validateSecretPayload(cr.Spec.ForProvider.SecretPayload, p.Child("secretPayload"))...)
+	return errs
+}
+
+func validateSecretTags(tags map[string]string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		kp := path.Key(k)
+		switch {
+		case k == "":
+			errs = append(errs, field.Invalid(kp, k, "tag key must not be empty"))
+		case strings.HasPrefix(strings.ToLower(k), "aws:"):
+			errs = append(errs, field.Invalid(kp, k, "tag keys starting with aws: are reserved"))
+		case len(k) > maxTagKeyLength:
+			errs = append(errs, field.TooLong(kp, k, maxTagKeyLength))
+		case len(tags[k]) > maxTagValueLength:
+			errs = append(errs, field.TooLong(kp, tags[k], maxTagValueLength))
+		}
+	}
+	return errs
+}
+
+func validateSecretPayload(payload *v1alpha1.SecretPayload, path *field.Path) field.ErrorList {
+	if payload == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	fields := append([]string{awsclient.FieldPublicKey, awsclient.FieldPrivateKey}, awsclient.OptionalFields...)
+	known := map[string]bool{}
+	for _, f := range fields {
+		known[f] = true
+	}
+
+	used := map[string]int{}
+	for _, f := range fields {
+		key := f
+		if k, ok := payload.KeyNames[f]; ok {
+			key = k
+		}
+		used[key]++
+	}
+	keys := make([]string, 0, len(payload.KeyNames))
+	for f := range payload.KeyNames {
+		keys = append(keys, f)
+	}
+	sort.Strings(keys)
+	for _, f := range keys {
+		kp := path.Child("keyNames").Key(f)
+		key := payload.KeyNames[f]
+		switch {
+		case !known[f]:
+			errs = append(errs, field.NotSupported(kp, f, fields))
+		case key == "":
+			errs = append(errs, field.Required(kp, "key name must not be empty"))
+		case used[key] > 1:
+			errs = append(errs, field.Duplicate(kp, key))
+		}
+	}
+	return errs
+}
+
+func validateOrganizationImmutable(oldCR, cr *v1alpha1.Organization) field.ErrorList {
+	var errs field.ErrorList
+	p := field.NewPath("spec", "forProvider")
+
+	// Imported organizations may start without an owner.
+	if oldCR.Spec.ForProvider.OwnerID != "" && oldCR.Spec.ForProvider.OwnerID != cr.Spec.ForProvider.OwnerID {
+		errs = append(errs, field.Forbidden(p.Child("ownerID"), "ownerID is immutable"))
+	}
+	if deref(oldCR.Spec.ForProvider.AWSSecretsConfig.SecretName) != deref(cr.Spec.ForProvider.AWSSecretsConfig.SecretName) {
+		errs = append(errs, field.Forbidden(p.Child("awsSecretsConfig", "secretName"), "secretName is immutable"))
+	}
+	return errs
+}
+
+// introducedErrors returns the errors of errs that are not in oldErrs, i.e.
+// the errors of the fields an update changed.
+func introducedErrors(oldErrs, errs field.ErrorList) field.ErrorList {
+	existing := map[string]bool{}
+	for _, e := range oldErrs {
+		existing[e.Error()] = true
+	}
+	var introduced field.ErrorList
+	for _, e := range errs {
+		if !existing[e.Error()] {
+			introduced = append(introduced, e)
+		}
+	}
+	return introduced
+}
+
+// validateAPIKey validates the API key created with an organization. It is
+// only required if the organization may be created.
+func validateAPIKey(apiKey *v1alpha1.OrganizationAPIKey, create bool, p *field.Path) field.ErrorList {
+	if apiKey == nil {
+		if create {
+			return field.ErrorList{field.Required(p, "apiKey is required to create an organization")}
+		}
+		return nil
+	}
+
+	var errs field.ErrorList
+	rolesPath := p.Child("roles")
+	if len(apiKey.Roles) == 0 && create {
+		errs = append(errs, field.Required(rolesPath, "at least one role is required"))
+	}
+	for i, role := range apiKey.Roles {
+		if !organizationRoles[role] {
+			errs = append(errs, field.NotSupported(rolesPath.Index(i), role, supportedRoles()))
+		}
+	}
+	for i, entry := range apiKey.AccessList {
+		if !validCIDR(entry) {
+			errs = append(errs, field.Invalid(p.Child("accessList").Index(i), entry, "must be an IP address or CIDR block"))
+		}
+	}
+	return errs
+}
+
+// createAllowed reports whether the management policies of a resource allow
+// the controller to create it. Imported resources only need to be observed.
+func createAllowed(mg resource.Managed) bool {
+	policies := mg.GetManagementPolicies()
+	if len(policies) == 0 {
+		return true
+	}
+	for _, p := range policies {
+		if p == xpv1.ManagementActionAll || p == xpv1.ManagementActionCreate {
+			return true
+		}
+	}
+	return false
+}
+
+// validCIDR reports whether s is an IP address or CIDR block.
+func validCIDR(s string) bool {
+	if net.ParseIP(s) != nil {
+		return true
+	}
+	_, _, err := net.ParseCIDR(s)
+	return err == nil
+}
+
+func supportedRoles() []string {
+	roles := make([]string, 0, len(organizationRoles))
+	for r := range organizationRoles {
+		roles = append(roles, r)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
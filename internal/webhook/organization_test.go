@@ -0,0 +1,239 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+)
+
+func organization(mod func(*v1alpha1.Organization)) *v1alpha1.Organization {
+	secretName := "test-org"
+	cr := &v1alpha1.Organization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-org"},
+		Spec: v1alpha1.OrganizationSpec{
+			ForProvider: v1alpha1.OrganizationParameters{
+				OwnerID: "68933765952cea244d470efb",
+				APIKey: &v1alpha1.OrganizationAPIKey{
+					Description: "initial org key",
+					Roles:       []string{"ORG_OWNER"},
+				},
+				AWSSecretsConfig: v1alpha1.AWSSecretsManagerReference{
+					Region:     "eu-central-1",
+					SecretName: &secretName,
+				},
+			},
+		},
+	}
+	if mod != nil {
+		mod(cr)
+	}
+	return cr
+}
+
+func TestOrganizationValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cr      *v1alpha1.Organization
+		wantErr bool
+	}{
+		{
+			name: "Valid",
+			cr:   organization(nil),
+		},
+		{
+			name:    "EmptyOwnerID",
+			cr:      organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.OwnerID = "" }),
+			wantErr: true,
+		},
+		{
+			name: "ObserveOnlyWithoutOwnerID",
+			cr: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.OwnerID = ""
+				cr.Spec.ForProvider.APIKey = nil
+				cr.Spec.ManagementPolicies = xpv1.ManagementPolicies{xpv1.ManagementActionObserve}
+			}),
+		},
+		{
+			name:    "MissingAPIKey",
+			cr:      organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.APIKey = nil }),
+			wantErr: true,
+		},
+		{
+			name:    "UnknownRole",
+			cr:      organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.APIKey.Roles = []string{"ORG_ADMIN"} }),
+			wantErr: true,
+		},
+		{
+			name:    "InvalidRegion",
+			cr:      organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.AWSSecretsConfig.Region = "europe" }),
+			wantErr: true,
+		},
+		{
+			name: "IllegalSecretName",
+			cr: organization(func(cr *v1alpha1.Organization) {
+				name := "test org!"
+				cr.Spec.ForProvider.AWSSecretsConfig.SecretName = &name
+			}),
+			wantErr: true,
+		},
+		{
+			name: "ReplicaInPrimaryRegion",
+			cr: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.AWSSecretsConfig.ReplicaRegions = []v1alpha1.SecretReplicaRegion{{Region: "eu-central-1"}}
+			}),
+			wantErr: true,
+		},
+		{
+			name: "CustomKeyNames",
+			cr: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.SecretPayload = &v1alpha1.SecretPayload{
+					KeyNames: map[string]string{"publicKey": "username", "privateKey": "password"},
+				}
+			}),
+		},
+		{
+			name: "DuplicateKeyName",
+			cr: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.SecretPayload = &v1alpha1.SecretPayload{
+					KeyNames: map[string]string{"orgId": "publicKey"},
+				}
+			}),
+			wantErr: true,
+		},
+		{
+			name: "AccessList",
+			cr: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.APIKey.AccessList = []string{"203.0.113.10", "198.51.100.0/24"}
+			}),
+		},
+		{
+			name: "InvalidAccessListEntry",
+			cr: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.APIKey.AccessList = []string{"198.51.100.0/33"}
+			}),
+			wantErr: true,
+		},
+		{
+			name: "InvalidSecurityContact",
+			cr: organization(func(cr *v1alpha1.Organization) {
+				contact := "security team"
+				cr.Spec.ForProvider.Settings = &v1alpha1.OrganizationSettings{SecurityContact: &contact}
+			}),
+			wantErr: true,
+		},
+		{
+			name: "UnknownKeyName",
+			cr: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.SecretPayload = &v1alpha1.SecretPayload{
+					KeyNames: map[string]string{"secret": "password"},
+				}
+			}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &organizationValidator{}
+			_, err := v.ValidateCreate(context.Background(), tt.cr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("organizationValidator.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrganizationValidator_ValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     *v1alpha1.Organization
+		new     *v1alpha1.Organization
+		wantErr bool
+	}{
+		{
+			name: "Unchanged",
+			old:  organization(nil),
+			new:  organization(nil),
+		},
+		{
+			name:    "OwnerIDChanged",
+			old:     organization(nil),
+			new:     organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.OwnerID = "616eafd9ec5b4a51a7c208db" }),
+			wantErr: true,
+		},
+		{
+			name: "SecretNameChanged",
+			old:  organization(nil),
+			new: organization(func(cr *v1alpha1.Organization) {
+				name := "other-org"
+				cr.Spec.ForProvider.AWSSecretsConfig.SecretName = &name
+			}),
+			wantErr: true,
+		},
+		{
+			name: "DeletingWithInvalidSpec",
+			old:  organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.AWSSecretsConfig.Region = "europe" }),
+			new: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.AWSSecretsConfig.Region = "europe"
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+			}),
+		},
+		{
+			name: "MetadataChangedWithInvalidSpec",
+			old:  organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.APIKey.Roles = []string{"ORG_ADMIN"} }),
+			new: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.APIKey.Roles = []string{"ORG_ADMIN"}
+				cr.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+			}),
+		},
+		{
+			name: "OtherFieldChangedWithInvalidSpec",
+			old:  organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.APIKey.Roles = []string{"ORG_ADMIN"} }),
+			new: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.APIKey.Roles = []string{"ORG_ADMIN"}
+				cr.Spec.ForProvider.APIKey.AccessList = []string{"10.0.0.0/8"}
+			}),
+		},
+		{
+			name: "ImportedWithoutAPIKey",
+			old: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.APIKey = nil
+				cr.Spec.ManagementPolicies = xpv1.ManagementPolicies{xpv1.ManagementActionObserve}
+			}),
+			new: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.APIKey = nil
+				cr.Spec.ManagementPolicies = xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionUpdate}
+			}),
+		},
+		{
+			name: "MissingAPIKeyNotIntroduced",
+			old:  organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.APIKey = nil }),
+			new: organization(func(cr *v1alpha1.Organization) {
+				cr.Spec.ForProvider.APIKey = nil
+				cr.Spec.ForProvider.AWSSecretsConfig.Region = "eu-west-1"
+			}),
+		},
+		{
+			name:    "InvalidFieldIntroduced",
+			old:     organization(nil),
+			new:     organization(func(cr *v1alpha1.Organization) { cr.Spec.ForProvider.APIKey.AccessList = []string{"not-an-ip"} }),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &organizationValidator{}
+			_, err := v.ValidateUpdate(context.Background(), tt.old, tt.new)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("organizationValidator.ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
+)
+
+const errNotProjectEncryptionAtRest = "object is not a ProjectEncryptionAtRest"
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-project-mongodb-allianz-io-v1alpha1-projectencryptionatrest,mutating=false,failurePolicy=fail,groups=project.mongodb.allianz.io,resources=projectencryptionatrests,versions=v1alpha1,name=projectencryptionatrests.project.mongodb.allianz.io,sideEffects=None,admissionReviewVersions=v1
+
+type projectEncryptionAtRestValidator struct{}
+
+func (v *projectEncryptionAtRestValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*v1alpha1.ProjectEncryptionAtRest)
+	if !ok {
+		return nil, errors.New(errNotProjectEncryptionAtRest)
+	}
+	return nil, toInvalid(v1alpha1.ProjectEncryptionAtRestGroupVersionKind.GroupKind(), cr.GetName(), validateProjectEncryptionAtRest(cr))
+}
+
+func (v *projectEncryptionAtRestValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	cr, ok := newObj.(*v1alpha1.ProjectEncryptionAtRest)
+	if !ok {
+		return nil, errors.New(errNotProjectEncryptionAtRest)
+	}
+	return nil, toInvalid(v1alpha1.ProjectEncryptionAtRestGroupVersionKind.GroupKind(), cr.GetName(), validateProjectEncryptionAtRest(cr))
+}
+
+func (v *projectEncryptionAtRestValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateProjectEncryptionAtRest requires exactly one of roleRef and roleID.
+func validateProjectEncryptionAtRest(cr *v1alpha1.ProjectEncryptionAtRest) field.ErrorList {
+	p := field.NewPath("spec", "forProvider")
+	params := cr.Spec.ForProvider
+
+	switch hasRef := params.RoleRef != nil && params.RoleRef.Name != ""; {
+	case hasRef && params.RoleID != "":
+		return field.ErrorList{field.Forbidden(p.Child("roleID"), "roleID must not be set together with roleRef")}
+	case !hasRef && params.RoleID == "":
+		return field.ErrorList{field.Required(p.Child("roleRef"), "one of roleRef or roleID is required")}
+	}
+	return nil
+}
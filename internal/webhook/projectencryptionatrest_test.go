@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
+)
+
+func TestProjectEncryptionAtRestValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		roleRef *xpv1.Reference
+		roleID  string
+		wantErr bool
+	}{
+		{
+			name:    "RoleRef",
+			roleRef: &xpv1.Reference{Name: "atlas-kms"},
+		},
+		{
+			name:   "RoleID",
+			roleID: "6fa5d7e2a1b3c4d5e6f7a8b9",
+		},
+		{
+			name:    "Both",
+			roleRef: &xpv1.Reference{Name: "atlas-kms"},
+			roleID:  "6fa5d7e2a1b3c4d5e6f7a8b9",
+			wantErr: true,
+		},
+		{
+			name:    "Neither",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := &v1alpha1.ProjectEncryptionAtRest{ObjectMeta: metav1.ObjectMeta{Name: "encryption"}}
+			cr.Spec.ForProvider.RoleRef = tt.roleRef
+			cr.Spec.ForProvider.RoleID = tt.roleID
+			_, err := (&projectEncryptionAtRestValidator{}).ValidateCreate(context.Background(), cr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/connectivity/v1alpha1"
+)
+
+const errNotVPCEndpoint = "object is not a VPCEndpoint"
+
+// AWS resource IDs are a prefix followed by 8 or 17 hexadecimal characters.
+var (
+	vpcIDRegexp           = regexp.MustCompile(`^vpc-([0-9a-f]{8}|[0-9a-f]{17})$`)
+	subnetIDRegexp        = regexp.MustCompile(`^subnet-([0-9a-f]{8}|[0-9a-f]{17})$`)
+	securityGroupIDRegexp = regexp.MustCompile(`^sg-([0-9a-f]{8}|[0-9a-f]{17})$`)
+	serviceNameRegexp     = regexp.MustCompile(`^com\.amazonaws\.vpce\.[a-z0-9-]+\.vpce-svc-[0-9a-f]{17}$`)
+	accountIDRegexp       = regexp.MustCompile(`^\d{12}$`)
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-connectivity-mongodb-allianz-io-v1alpha1-vpcendpoint,mutating=false,failurePolicy=fail,groups=connectivity.mongodb.allianz.io,resources=vpcendpoints,versions=v1alpha1,name=vpcendpoints.connectivity.mongodb.allianz.io,sideEffects=None,admissionReviewVersions=v1
+
+type vpcEndpointValidator struct{}
+
+func (v *vpcEndpointValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cr, ok := obj.(*v1alpha1.VPCEndpoint)
+	if !ok {
+		return nil, errors.New(errNotVPCEndpoint)
+	}
+	return nil, toInvalid(v1alpha1.VPCEndpointGroupVersionKind.GroupKind(), cr.GetName(), validateVPCEndpoint(cr))
+}
+
+// ValidateUpdate only rejects errors introduced by the update, so endpoints
+// created before validation was tightened can still be updated and deleted.
+func (v *vpcEndpointValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldCR, ok := oldObj.(*v1alpha1.VPCEndpoint)
+	if !ok {
+		return nil, errors.New(errNotVPCEndpoint)
+	}
+	cr, ok := newObj.(*v1alpha1.VPCEndpoint)
+	if !ok {
+		return nil, errors.New(errNotVPCEndpoint)
+	}
+	if meta.WasDeleted(cr) || equality.Semantic.DeepEqual(oldCR.Spec, cr.Spec) {
+		return nil, nil
+	}
+	errs := introducedErrors(validateVPCEndpoint(oldCR), validateVPCEndpoint(cr))
+	return nil, toInvalid(v1alpha1.VPCEndpointGroupVersionKind.GroupKind(), cr.GetName(), errs)
+}
+
+func (v *vpcEndpointValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateVPCEndpoint(cr *v1alpha1.VPCEndpoint) field.ErrorList {
+	var errs field.ErrorList
+	p := field.NewPath("spec", "forProvider")
+	params := cr.Spec.ForProvider
+
+	errs = append(errs, validateID(params.VpcID, vpcIDRegexp, p.Child("vpcId"), "vpc-0123456789abcdef0")...)
+	errs = append(errs, validateID(params.ServiceName, serviceNameRegexp, p.Child("serviceName"), "com.amazonaws.vpce.eu-central-1.vpce-svc-0123456789abcdef0")...)
+	errs = append(errs, validateID(params.AccountID, accountIDRegexp, p.Child("accountId"), "123456789012")...)
+	errs = append(errs, validateRegion(params.Region, p.Child("region"))...)
+
+	if len(params.SubnetIDs) == 0 {
+		errs = append(errs, field.Required(p.Child("subnetIds"), "at least one subnet is required"))
+	}
+	for i, id := range params.SubnetIDs {
+		errs = append(errs, validateID(id, subnetIDRegexp, p.Child("subnetIds").Index(i), "subnet-0123456789abcdef0")...)
+	}
+	for i, id := range params.SecurityGroupIDs {
+		errs = append(errs, validateID(id, securityGroupIDRegexp, p.Child("securityIds").Index(i), "sg-0123456789abcdef0")...)
+	}
+	return errs
+}
+
+func validateID(id string, re *regexp.Regexp, path *field.Path, example string) field.ErrorList {
+	if id == "" {
+		return field.ErrorList{field.Required(path, "")}
+	}
+	if !re.MatchString(id) {
+		return field.ErrorList{field.Invalid(path, id, "must be a valid AWS identifier, e.g. "+example)}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/svchaudhari/Swap-Provider-MongoDB/apis/connectivity/v1alpha1"
+)
+
+func vpcEndpoint(mod func(*v1alpha1.VPCEndpoint)) *v1alpha1.VPCEndpoint {
+	cr := &v1alpha1.VPCEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-endpoint"},
+		Spec: v1alpha1.VPCEndpointSpec{
+			ForProvider: v1alpha1.VPCEndpointParameters{
+				VpcID:            "vpc-03a75e9d856407da5",
+				ServiceName:      "com.amazonaws.vpce.eu-central-1.vpce-svc-02c21ee840752cff7",
+				AccountID:        "198927051560",
+				SubnetIDs:        []string{"subnet-000ff8403aca2347d"},
+				SecurityGroupIDs: []string{"sg-0333847892bf56879"},
+				Region:           "eu-central-1",
+			},
+		},
+	}
+	if mod != nil {
+		mod(cr)
+	}
+	return cr
+}
+
+func TestVPCEndpointValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cr      *v1alpha1.VPCEndpoint
+		wantErr bool
+	}{
+		{
+			name: "Valid",
+			cr:   vpcEndpoint(nil),
+		},
+		{
+			name:    "InvalidVpcID",
+			cr:      vpcEndpoint(func(cr *v1alpha1.VPCEndpoint) { cr.Spec.ForProvider.VpcID = "vpc-1" }),
+			wantErr: true,
+		},
+		{
+			name:    "NoSubnets",
+			cr:      vpcEndpoint(func(cr *v1alpha1.VPCEndpoint) { cr.Spec.ForProvider.SubnetIDs = nil }),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &vpcEndpointValidator{}
+			_, err := v.ValidateCreate(context.Background(), tt.cr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("vpcEndpointValidator.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVPCEndpointValidator_ValidateUpdate(t *testing.T) {
+	invalidVpcID := func(cr *v1alpha1.VPCEndpoint) { cr.Spec.ForProvider.VpcID = "vpc-1" }
+
+	tests := []struct {
+		name    string
+		old     *v1alpha1.VPCEndpoint
+		new     *v1alpha1.VPCEndpoint
+		wantErr bool
+	}{
+		{
+			name: "Valid",
+			old:  vpcEndpoint(nil),
+			new:  vpcEndpoint(func(cr *v1alpha1.VPCEndpoint) { cr.Spec.ForProvider.SecurityGroupIDs = nil }),
+		},
+		{
+			name: "DeletingWithInvalidSpec",
+			old:  vpcEndpoint(invalidVpcID),
+			new: vpcEndpoint(func(cr *v1alpha1.VPCEndpoint) {
+				invalidVpcID(cr)
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+			}),
+		},
+		{
+			name: "MetadataChangedWithInvalidSpec",
+			old:  vpcEndpoint(invalidVpcID),
+			new: vpcEndpoint(func(cr *v1alpha1.VPCEndpoint) {
+				invalidVpcID(cr)
+				cr.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+			}),
+		},
+		{
+			name: "OtherFieldChangedWithInvalidSpec",
+			old:  vpcEndpoint(invalidVpcID),
+			new: vpcEndpoint(func(cr *v1alpha1.VPCEndpoint) {
+				invalidVpcID(cr)
+				cr.Spec.ForProvider.SecurityGroupIDs = nil
+			}),
+		},
+		{
+			name:    "InvalidFieldIntroduced",
+			old:     vpcEndpoint(nil),
+			new:     vpcEndpoint(invalidVpcID),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &vpcEndpointValidator{}
+			_, err := v.ValidateUpdate(context.Background(), tt.old, tt.new)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("vpcEndpointValidator.ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
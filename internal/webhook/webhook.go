@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook contains the validating admission webhooks of the provider
+package webhook
+
+import (
+	"regexp"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	connectivityv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/connectivity/v1alpha1"
+	organizationv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/organization/v1alpha1"
+	projectv1alpha1 "github.com/svchaudhari/Swap-Provider-MongoDB/apis/project/v1alpha1"
+)
+
+var regionRegexp = regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]?)?-[a-z]+-\d$`)
+
+// Setup registers the validating webhooks of all managed resources with the
+// webhook server of the supplied manager.
+func Setup(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&organizationv1alpha1.Organization{}).
+		WithValidator(&organizationValidator{}).
+		Complete(); err != nil {
+		return err
+	}
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&connectivityv1alpha1.VPCEndpoint{}).
+		WithValidator(&vpcEndpointValidator{}).
+		Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&projectv1alpha1.ProjectEncryptionAtRest{}).
+		WithValidator(&projectEncryptionAtRestValidator{}).
+		Complete()
+}
+
+func validateRegion(region string, path *field.Path) field.ErrorList {
+	if region == "" {
+		return field.ErrorList{field.Required(path, "region is required")}
+	}
+	if !regionRegexp.MatchString(region) {
+		return field.ErrorList{field.Invalid(path, region, "must be a valid AWS region, e.g. eu-central-1")}
+	}
+	return nil
+}
+
+// toInvalid converts validation errors into an Invalid API error, or returns
+// nil if there are none.
+func toInvalid(gk schema.GroupKind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return kerrors.NewInvalid(gk, name, errs)
+}